@@ -9,6 +9,7 @@ package rod
 
 import (
 	"context"
+	"errors"
 	"reflect"
 	"strings"
 	"sync"
@@ -52,6 +53,7 @@ type Browser struct {
 	defaultDevice devices.Device
 
 	controlURL  string
+	launcher    *launcher.Launcher // only set when the browser is launched by Connect
 	client      CDPClient
 	event       *goob.Observable // all the browser events from cdp client
 	targetsLock *sync.Mutex
@@ -82,6 +84,9 @@ func New() *Browser {
 }
 
 // Incognito creates a new incognito browser.
+// It creates a new browser context in the same browser process, the pages created by it will have
+// their own cookies, storage, and cache, which is a cheap way to isolate tests that run in parallel.
+// Use [Browser.Close] on it to dispose the browser context and all its pages.
 func (b *Browser) Incognito() (*Browser, error) {
 	res, err := proto.TargetCreateBrowserContext{}.Call(b)
 	if err != nil {
@@ -150,10 +155,12 @@ func (b *Browser) Connect() error {
 		u := b.controlURL
 		if u == "" {
 			var err error
-			u, err = launcher.New().Context(b.ctx).Launch()
+			l := launcher.New().Context(b.ctx)
+			u, err = l.Launch()
 			if err != nil {
 				return err
 			}
+			b.launcher = l
 		}
 
 		c, err := cdp.StartWithURL(b.ctx, u, nil)
@@ -174,14 +181,44 @@ func (b *Browser) Connect() error {
 	return proto.TargetSetDiscoverTargets{Discover: true}.Call(b)
 }
 
-// Close the browser.
+// Close the browser. If it's an incognito browser, only its browser context will be disposed.
+// If the browser is launched by [Browser.Connect], it also waits for the browser process to exit,
+// the process will be killed if it doesn't exit within 5 seconds or before the context of the browser is done,
+// so no orphaned process will be left. If the close call fails, such as the connection is already dead,
+// the process will be killed immediately and the error will be returned, so closing twice is safe.
 func (b *Browser) Close() error {
 	if b.BrowserContextID == "" {
-		return proto.BrowserClose{}.Call(b)
+		err := proto.BrowserClose{}.Call(b)
+
+		if b.launcher != nil {
+			b.waitExit(err != nil)
+		}
+
+		return err
 	}
 	return proto.TargetDisposeBrowserContext{BrowserContextID: b.BrowserContextID}.Call(b)
 }
 
+// waitExit waits for the launched browser process to exit, and kills it if the close call failed,
+// the context of the browser is done, or it doesn't exit within the grace period.
+func (b *Browser) waitExit(failed bool) {
+	if failed {
+		select {
+		case <-b.launcher.Exited():
+			return
+		default:
+		}
+	} else {
+		select {
+		case <-b.launcher.Exited():
+			return
+		case <-b.ctx.Done():
+		case <-time.After(5 * time.Second):
+		}
+	}
+	b.launcher.Kill()
+}
+
 // Page creates a new browser tab. If opts.URL is empty, the default target will be "about:blank".
 func (b *Browser) Page(opts proto.TargetCreateTarget) (p *Page, err error) {
 	req := opts
@@ -236,6 +273,50 @@ func (b *Browser) Pages() (Pages, error) {
 	return pageList, nil
 }
 
+// WaitPage waits for the next page created in the browser context, such as a popup opened by window.open
+// or a link with target=_blank. Use [Page.WaitOpen] if you only care about the pages opened by a specific page.
+func (b *Browser) WaitPage() func() (*Page, error) {
+	var targetID proto.TargetTargetID
+
+	wait := b.EachEvent(func(e *proto.TargetTargetCreated) bool {
+		if e.TargetInfo.Type != proto.TargetTargetInfoTypePage {
+			return false
+		}
+		if b.BrowserContextID != "" && e.TargetInfo.BrowserContextID != b.BrowserContextID {
+			return false
+		}
+		targetID = e.TargetInfo.TargetID
+		return true
+	})
+
+	return func() (*Page, error) {
+		wait()
+		if targetID == "" {
+			return nil, b.ctx.Err()
+		}
+		return b.PageFromTarget(targetID)
+	}
+}
+
+// WaitPageByURL waits until a page whose url matches the jsRegex shows up, and returns it.
+// It's useful to get a tab opened asynchronously, use [Pages.FindByURL] if the page should already exist.
+func (b *Browser) WaitPageByURL(jsRegex string) (*Page, error) {
+	var page *Page
+	err := utils.Retry(b.ctx, b.sleeper(), func() (bool, error) {
+		list, err := b.Pages()
+		if err != nil {
+			return true, err
+		}
+
+		page, err = list.FindByURL(jsRegex)
+		if errors.Is(err, &PageNotFoundError{}) {
+			return false, nil
+		}
+		return true, err
+	})
+	return page, err
+}
+
 // Call implements the [proto.Client] to call raw cdp interface directly.
 func (b *Browser) Call(ctx context.Context, sessionID, methodName string, params interface{}) (res []byte, err error) {
 	res, err = b.client.Call(ctx, sessionID, methodName, params)
@@ -257,6 +338,9 @@ func (b *Browser) PageFromSession(sessionID proto.TargetSessionID) *Page {
 		sleeper:       b.sleeper,
 		browser:       b,
 		SessionID:     sessionID,
+		network:       &networkEmulation{},
+		objects:       &objectTracker{},
+		utility:       &utilityWorlds{},
 	}
 }
 
@@ -292,6 +376,9 @@ func (b *Browser) PageFromTarget(targetID proto.TargetTargetID) (*Page, error) {
 		jsCtxLock:     &sync.Mutex{},
 		jsCtxID:       new(proto.RuntimeRemoteObjectID),
 		helpersLock:   &sync.Mutex{},
+		network:       &networkEmulation{},
+		objects:       &objectTracker{},
+		utility:       &utilityWorlds{},
 	}
 
 	page.root = page
@@ -477,6 +564,7 @@ func (b *Browser) IgnoreCertErrors(enable bool) error {
 }
 
 // GetCookies from the browser.
+// For an incognito browser, only the cookies of its own browser context will be returned.
 func (b *Browser) GetCookies() ([]*proto.NetworkCookie, error) {
 	res, err := proto.StorageGetCookies{BrowserContextID: b.BrowserContextID}.Call(b)
 	if err != nil {
@@ -486,6 +574,9 @@ func (b *Browser) GetCookies() ([]*proto.NetworkCookie, error) {
 }
 
 // SetCookies to the browser. If the cookies is nil it will clear all the cookies.
+// The cookies are shared by all the pages of the browser, including the pages created later.
+// Cookies of an incognito browser, such as the one from [Browser.Incognito], are isolated from
+// other browser contexts, so they won't leak into the default one and vice versa.
 func (b *Browser) SetCookies(cookies []*proto.NetworkCookieParam) error {
 	if cookies == nil {
 		return proto.StorageClearCookies{BrowserContextID: b.BrowserContextID}.Call(b)
@@ -1,6 +1,7 @@
 package rod_test
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -41,6 +42,18 @@ func TestIncognito(t *testing.T) {
 	})
 }
 
+func TestIncognitoClose(t *testing.T) {
+	g := setup(t)
+
+	b := g.browser.MustIncognito()
+	p := b.MustPage()
+	b.MustClose()
+
+	for _, page := range g.browser.MustPages() {
+		g.Neq(page.TargetID, p.TargetID)
+	}
+}
+
 func TestBrowserResetControlURL(_ *testing.T) {
 	rod.New().ControlURL("test").ControlURL("")
 }
@@ -122,6 +135,40 @@ func TestBrowserPages(t *testing.T) {
 	})
 }
 
+func TestBrowserWaitPage(t *testing.T) {
+	g := setup(t)
+
+	page := g.newPage(g.srcFile("fixtures/open-page.html"))
+
+	wait := g.browser.MustWaitPage()
+	page.MustElement("a").MustClick()
+
+	newPage := wait()
+	defer newPage.MustClose()
+
+	g.Eq("new page", newPage.MustEval("() => window.a").String())
+
+	_, err := g.browser.Context(g.Timeout(0)).WaitPage()()
+	g.Err(err)
+}
+
+func TestBrowserWaitPageByURL(t *testing.T) {
+	g := setup(t)
+
+	page := g.newPage(g.srcFile("fixtures/open-page.html"))
+	page.MustEval(`() => setTimeout(() => document.querySelector("a").click(), 300)`)
+
+	newPage := g.browser.MustWaitPageByURL("open-page-subpage.html$")
+	defer newPage.MustClose()
+	g.Regex("open-page-subpage.html$", newPage.MustInfo().URL)
+
+	_, err := g.browser.Timeout(100 * time.Millisecond).WaitPageByURL("not-exists")
+	g.Is(err, context.DeadlineExceeded)
+
+	g.mc.stubErr(1, proto.TargetGetTargets{})
+	g.Err(g.browser.WaitPageByURL(""))
+}
+
 func TestBrowserClearStates(t *testing.T) {
 	g := setup(t)
 
@@ -317,6 +364,33 @@ func TestBrowserCookies(t *testing.T) {
 	g.Err(b.GetCookies())
 }
 
+func TestBrowserCookiesSharedByPages(t *testing.T) {
+	g := setup(t)
+
+	s := g.Serve()
+	s.Route("/", ".html", "<html></html>")
+
+	b := g.browser.MustIncognito()
+	defer b.MustClose()
+
+	g.E(b.SetCookies([]*proto.NetworkCookieParam{{
+		Name:  "a",
+		Value: "val",
+		URL:   s.URL(),
+	}}))
+
+	for i := 0; i < 2; i++ {
+		p := b.MustPage(s.URL())
+		g.Eq(p.MustEval(`() => document.cookie`).Str(), "a=val")
+		p.MustClose()
+	}
+
+	// cookies of the incognito browser won't leak into other contexts
+	other := g.browser.MustIncognito()
+	defer other.MustClose()
+	g.Len(other.MustGetCookies(), 0)
+}
+
 func TestWaitDownload(t *testing.T) {
 	g := setup(t)
 
@@ -429,6 +503,19 @@ func TestStreamReader(t *testing.T) {
 	g.Err(err)
 }
 
+func TestBrowserCloseLaunched(t *testing.T) {
+	g := setup(t)
+
+	b := rod.New().Context(g.Context()).MustConnect()
+	b.MustPage(g.blank())
+	b.MustClose()
+
+	g.Err(b.Pages())
+
+	// the browser is already gone
+	g.Err(b.Close())
+}
+
 func TestBrowserConnectFailure(t *testing.T) {
 	g := setup(t)
 
@@ -34,8 +34,12 @@ func (b *Browser) Timeout(d time.Duration) *Browser {
 }
 
 // CancelTimeout cancels the current timeout context and returns a clone with the parent context.
+// If there's no timeout, it returns itself.
 func (b *Browser) CancelTimeout() *Browser {
-	val := b.ctx.Value(timeoutContextKey{}).(*timeoutContextVal) //nolint:forcetypeassert
+	val, ok := b.ctx.Value(timeoutContextKey{}).(*timeoutContextVal)
+	if !ok {
+		return b
+	}
 	val.cancel()
 	return b.Context(val.parent)
 }
@@ -74,8 +78,12 @@ func (p *Page) Timeout(d time.Duration) *Page {
 }
 
 // CancelTimeout cancels the current timeout context and returns a clone with the parent context.
+// If there's no timeout, it returns itself.
 func (p *Page) CancelTimeout() *Page {
-	val := p.ctx.Value(timeoutContextKey{}).(*timeoutContextVal) //nolint: forcetypeassert
+	val, ok := p.ctx.Value(timeoutContextKey{}).(*timeoutContextVal)
+	if !ok {
+		return p
+	}
 	val.cancel()
 	return p.Context(val.parent)
 }
@@ -112,8 +120,12 @@ func (el *Element) Timeout(d time.Duration) *Element {
 }
 
 // CancelTimeout cancels the current timeout context and returns a clone with the parent context.
+// If there's no timeout, it returns itself.
 func (el *Element) CancelTimeout() *Element {
-	val := el.ctx.Value(timeoutContextKey{}).(*timeoutContextVal) //nolint: forcetypeassert
+	val, ok := el.ctx.Value(timeoutContextKey{}).(*timeoutContextVal)
+	if !ok {
+		return el
+	}
 	val.cancel()
 	return el.Context(val.parent)
 }
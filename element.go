@@ -4,7 +4,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"os"
+	"path/filepath"
 	"reflect"
+	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -77,15 +82,173 @@ func (el *Element) ScrollIntoView() error {
 	return proto.DOMScrollIntoViewIfNeeded{ObjectID: el.id()}.Call(el)
 }
 
+// ScrollAlignment enum for [Element.ScrollIntoViewAligned].
+// Doc: https://developer.mozilla.org/en-US/docs/Web/API/Element/scrollIntoView#block
+type ScrollAlignment string
+
+const (
+	// ScrollAlignmentStart aligns the element to the start of the visible area.
+	ScrollAlignmentStart ScrollAlignment = "start"
+	// ScrollAlignmentCenter aligns the element to the center of the visible area.
+	ScrollAlignmentCenter ScrollAlignment = "center"
+	// ScrollAlignmentEnd aligns the element to the end of the visible area.
+	ScrollAlignmentEnd ScrollAlignment = "end"
+	// ScrollAlignmentNearest scrolls as little as possible to make the element visible.
+	ScrollAlignmentNearest ScrollAlignment = "nearest"
+)
+
+// ScrollIntoViewAligned is similar to [Element.ScrollIntoView], but it always scrolls and aligns the element
+// with the block (vertical) and inline (horizontal) alignment, such as centering the element so that it won't
+// be hidden behind a fixed header. The empty block defaults to "start", the empty inline defaults to "nearest".
+func (el *Element) ScrollIntoViewAligned(block, inline ScrollAlignment) error {
+	defer el.tryTrace(TraceTypeInput, "scroll into view aligned")()
+	el.page.browser.trySlowMotion()
+
+	err := el.WaitStableRAF()
+	if err != nil {
+		return err
+	}
+
+	_, err = el.Evaluate(Eval(`(block, inline) => this.scrollIntoView({
+		block: block || 'start',
+		inline: inline || 'nearest',
+	})`, block, inline).ByUser())
+	return err
+}
+
 // Hover the mouse over the center of the element.
 // Before the action, it will try to scroll to the element and wait until it's interactable.
 func (el *Element) Hover() error {
+	return el.HoverLinear(1)
+}
+
+// HoverLinear is similar to [Element.Hover], but it moves the mouse to the element with the given steps,
+// check [Mouse.MoveLinear] for details. More steps make the mousemove listeners on the page fire more naturally.
+// If steps is less than 1, it will be 1.
+func (el *Element) HoverLinear(steps int) error {
+	if steps < 1 {
+		steps = 1
+	}
+
 	pt, err := el.WaitInteractable()
 	if err != nil {
 		return err
 	}
 
-	return el.page.Context(el.ctx).Mouse.MoveTo(*pt)
+	return el.page.Context(el.ctx).Mouse.MoveLinear(*pt, steps)
+}
+
+// MoveMouseTo scrolls the element into view and moves the mouse to the center of its box.
+// It returns the point the mouse lands on, such as to drag it to another point with [Mouse.Down] and [Mouse.Up].
+func (el *Element) MoveMouseTo() (*proto.Point, error) {
+	err := el.ScrollIntoView()
+	if err != nil {
+		return nil, err
+	}
+
+	shape, err := el.Shape()
+	if err != nil {
+		return nil, err
+	}
+
+	box := shape.Box()
+	if box == nil {
+		return nil, &InvisibleShapeError{el}
+	}
+
+	pt := proto.NewPoint(box.X+box.Width/2, box.Y+box.Height/2)
+
+	err = el.page.Context(el.ctx).Mouse.MoveTo(pt)
+	if err != nil {
+		return nil, err
+	}
+	return &pt, nil
+}
+
+// DragTo drags the element and drops it on the dst element.
+// It presses the mouse on the center of the element, scrolls the dst into view, moves the mouse
+// to the center of the dst with interpolated steps, then releases the mouse.
+// If the element is draggable, such as draggable="true", the mouse events won't trigger the HTML5 drag-and-drop,
+// so it will dispatch the synthetic dragstart, dragenter, dragover, drop, and dragend events instead.
+func (el *Element) DragTo(dst *Element) error {
+	res, err := el.Eval(`() => this.draggable`)
+	if err != nil {
+		return err
+	}
+	if res.Value.Bool() {
+		return el.dragToHTML5(dst)
+	}
+
+	_, err = el.MoveMouseTo()
+	if err != nil {
+		return err
+	}
+
+	defer el.tryTrace(TraceTypeInput, "drag")()
+
+	mouse := el.page.Context(el.ctx).Mouse
+
+	err = mouse.Down(proto.InputMouseButtonLeft, 1)
+	if err != nil {
+		return err
+	}
+
+	err = dst.ScrollIntoView()
+	if err != nil {
+		return err
+	}
+
+	shape, err := dst.Shape()
+	if err != nil {
+		return err
+	}
+
+	box := shape.Box()
+	if box == nil {
+		return &InvisibleShapeError{dst}
+	}
+
+	err = mouse.MoveLinear(proto.NewPoint(box.X+box.Width/2, box.Y+box.Height/2), 5) //nolint: mnd
+	if err != nil {
+		return err
+	}
+
+	return mouse.Up(proto.InputMouseButtonLeft, 1)
+}
+
+func (el *Element) dragToHTML5(dst *Element) error {
+	err := el.ScrollIntoView()
+	if err != nil {
+		return err
+	}
+
+	err = dst.ScrollIntoView()
+	if err != nil {
+		return err
+	}
+
+	defer el.tryTrace(TraceTypeInput, "drag")()
+
+	_, err = el.Evaluate(Eval(`dst => {
+		const data = new DataTransfer()
+		const fire = (target, type) => {
+			const r = target.getBoundingClientRect()
+			target.dispatchEvent(new DragEvent(type, {
+				bubbles: true,
+				cancelable: true,
+				composed: true,
+				dataTransfer: data,
+				clientX: r.x + r.width / 2,
+				clientY: r.y + r.height / 2,
+			}))
+		}
+		fire(this, 'dragstart')
+		fire(dst, 'dragenter')
+		fire(dst, 'dragover')
+		fire(dst, 'drop')
+		fire(this, 'dragend')
+	}`, dst.Object).ByUser())
+	return err
 }
 
 // MoveMouseOut of the current element.
@@ -117,6 +280,40 @@ func (el *Element) Click(button proto.InputMouseButton, clickCount int) error {
 	return el.page.Context(el.ctx).Mouse.Click(button, clickCount)
 }
 
+// RightClick is a shortcut for [Element.Click] with the right button.
+// The native context menu is not a part of the page, it won't block the page or rod, and in headless mode
+// it never shows up. Use [Page.PreventContextMenu] if you want to stop it from showing up in headful mode.
+func (el *Element) RightClick() error {
+	return el.Click(proto.InputMouseButtonRight, 1)
+}
+
+// DoubleClick will press then release the button twice just like a human, the second pair
+// of the events has the click count set to 2, so that the page will receive a real "dblclick" event.
+// Before the action, it will try to scroll to the element, hover the mouse over it,
+// wait until the it's interactable and enabled.
+func (el *Element) DoubleClick(button proto.InputMouseButton) error {
+	err := el.Hover()
+	if err != nil {
+		return err
+	}
+
+	err = el.WaitEnabled()
+	if err != nil {
+		return err
+	}
+
+	defer el.tryTrace(TraceTypeInput, string(button)+" double click")()
+
+	mouse := el.page.Context(el.ctx).Mouse
+
+	err = mouse.Click(button, 1)
+	if err != nil {
+		return err
+	}
+
+	return mouse.Click(button, 2)
+}
+
 // Tap will scroll to the button and tap it just like a human.
 // Before the action, it will try to scroll to the element and wait until it's interactable and enabled.
 func (el *Element) Tap() error {
@@ -212,6 +409,16 @@ func (el *Element) Type(keys ...input.Key) error {
 	return el.page.Context(el.ctx).Keyboard.Type(keys...)
 }
 
+// TypeText is similar to [Keyboard.TypeText].
+// Before the action, it will try to scroll to the element and focus on it.
+func (el *Element) TypeText(text string, delay time.Duration) error {
+	err := el.Focus()
+	if err != nil {
+		return err
+	}
+	return el.page.Context(el.ctx).Keyboard.TypeText(text, delay)
+}
+
 // KeyActions is similar with Page.KeyActions.
 // Before the action, it will try to scroll to the element and focus on it.
 func (el *Element) KeyActions() (*KeyActions, error) {
@@ -255,9 +462,7 @@ func (el *Element) SelectAllText() error {
 
 // Input focuses on the element and input text to it.
 // Before the action, it will scroll to the element, wait until it's visible, enabled and writable.
-// To empty the input you can use something like
-//
-//	el.SelectAllText().MustInput("")
+// To empty the input you can use [Element.Clear].
 func (el *Element) Input(text string) error {
 	err := el.Focus()
 	if err != nil {
@@ -279,6 +484,78 @@ func (el *Element) Input(text string) error {
 	return err
 }
 
+// Clear empties the input, textarea or contenteditable element. For input and textarea it selects all the text,
+// then presses the backspace key, so that the page will receive a real input event.
+// For contenteditable it sets the innerHTML to empty, so no leftover markup like "<br>" remains,
+// then dispatches an input event.
+// Before the action, it will scroll to the element, wait until it's visible, enabled and writable.
+func (el *Element) Clear() error {
+	err := el.Focus()
+	if err != nil {
+		return err
+	}
+
+	err = el.WaitEnabled()
+	if err != nil {
+		return err
+	}
+
+	err = el.WaitWritable()
+	if err != nil {
+		return err
+	}
+
+	defer el.tryTrace(TraceTypeInput, "clear")()
+
+	res, err := el.Evaluate(Eval(`() => {
+		if (!this.isContentEditable) return false
+		this.innerHTML = ''
+		this.dispatchEvent(new Event('input', { bubbles: true }))
+		return true
+	}`).ByUser())
+	if err != nil {
+		return err
+	}
+	if res.Value.Bool() {
+		return nil
+	}
+
+	_, err = el.Evaluate(evalHelper(js.SelectAllText).ByUser())
+	if err != nil {
+		return err
+	}
+
+	return el.page.Context(el.ctx).Keyboard.Type(input.Backspace)
+}
+
+// InputClear is similar to [Element.Input], but it selects all the existing text of the element before the input,
+// so that the element will end up with exactly the text. The input event will only be fired once at the end.
+func (el *Element) InputClear(text string) error {
+	err := el.Focus()
+	if err != nil {
+		return err
+	}
+
+	err = el.WaitEnabled()
+	if err != nil {
+		return err
+	}
+
+	err = el.WaitWritable()
+	if err != nil {
+		return err
+	}
+
+	_, err = el.Evaluate(evalHelper(js.SelectAllText).ByUser())
+	if err != nil {
+		return err
+	}
+
+	err = el.page.Context(el.ctx).InsertText(text)
+	_, _ = el.Evaluate(evalHelper(js.InputEvent).ByUser())
+	return err
+}
+
 // InputTime focuses on the element and input time to it.
 // Before the action, it will scroll to the element, wait until it's visible, enabled and writable.
 // It will wait until the element is visible, enabled and writable.
@@ -356,9 +633,35 @@ func (el *Element) Select(selectors []string, selected bool, t SelectorType) err
 	return nil
 }
 
+// SelectByText selects the children option elements whose trimmed text equals one of the texts.
+// For a multiple select element all of them will be selected, for a single select element the last one wins.
+// Like [Element.Select], the input and change events will be triggered.
+func (el *Element) SelectByText(texts ...string) error {
+	selectors := make([]string, len(texts))
+	for i, t := range texts {
+		selectors[i] = `^\s*` + regexp.QuoteMeta(t) + `\s*$`
+	}
+	return el.Select(selectors, true, SelectorTypeRegex)
+}
+
+// SelectByValue selects the children option elements whose value equals one of the values.
+// The value of an option without the value attribute is its text.
+func (el *Element) SelectByValue(values ...string) error {
+	return el.Select(values, true, SelectorTypeValue)
+}
+
+// SelectByIndex selects the children option elements by their indexes, the index starts from 0.
+func (el *Element) SelectByIndex(indexes ...int) error {
+	selectors := make([]string, len(indexes))
+	for i, index := range indexes {
+		selectors[i] = strconv.Itoa(index)
+	}
+	return el.Select(selectors, true, SelectorTypeIndex)
+}
+
 // Matches checks if the element can be selected by the css selector.
 func (el *Element) Matches(selector string) (bool, error) {
-	res, err := el.Eval(`s => this.matches(s)`, selector)
+	res, err := el.evalUtility(Eval(`s => this.matches(s)`, selector))
 	if err != nil {
 		return false, err
 	}
@@ -382,6 +685,18 @@ func (el *Element) Attribute(name string) (*string, error) {
 	return &s, nil
 }
 
+// SetAttribute of the DOM object.
+func (el *Element) SetAttribute(name, value string) error {
+	_, err := el.Eval("(n, v) => this.setAttribute(n, v)", name, value)
+	return err
+}
+
+// RemoveAttribute of the DOM object.
+func (el *Element) RemoveAttribute(name string) error {
+	_, err := el.Eval("(n) => this.removeAttribute(n)", name)
+	return err
+}
+
 // Property of the DOM object.
 // Property vs Attribute:
 // https://stackoverflow.com/questions/6003819/what-is-the-difference-between-properties-and-attributes-in-html
@@ -394,6 +709,36 @@ func (el *Element) Property(name string) (gson.JSON, error) {
 	return prop.Value, nil
 }
 
+// SetProperty of the DOM object, the value will be passed to the browser as a JSON value.
+// It sets the property directly, no input event will be triggered.
+// If the property is not writable an [EvalError] will be returned.
+func (el *Element) SetProperty(name string, value interface{}) error {
+	_, err := el.Eval(`(n, v) => { 'use strict'; this[n] = v }`, name, value)
+	return err
+}
+
+// ComputedStyle returns the computed style properties of the element, such as "color" or "font-size".
+// If no props are specified, all the properties will be returned.
+// The values are normalized by the browser, such as "rgb(255, 0, 0)" for the color "red" and "16px" for "1em".
+func (el *Element) ComputedStyle(props ...string) (map[string]string, error) {
+	res, err := el.Eval(`(props) => {
+		const s = getComputedStyle(this)
+		const names = props && props.length ? props : Array.from(s)
+		const style = {}
+		for (const name of names) style[name] = s.getPropertyValue(name)
+		return style
+	}`, props)
+	if err != nil {
+		return nil, err
+	}
+
+	style := map[string]string{}
+	for k, v := range res.Value.Map() {
+		style[k] = v.Str()
+	}
+	return style, nil
+}
+
 // Disabled checks if the element is disabled.
 func (el *Element) Disabled() (bool, error) {
 	prop, err := el.Property("disabled")
@@ -403,10 +748,67 @@ func (el *Element) Disabled() (bool, error) {
 	return prop.Bool(), nil
 }
 
+// Checked checks if the checkbox or radio element is checked.
+func (el *Element) Checked() (bool, error) {
+	prop, err := el.Property("checked")
+	if err != nil {
+		return false, err
+	}
+	return prop.Bool(), nil
+}
+
+// Selected checks if the option element is selected.
+func (el *Element) Selected() (bool, error) {
+	prop, err := el.Property("selected")
+	if err != nil {
+		return false, err
+	}
+	return prop.Bool(), nil
+}
+
+// SetChecked clicks the checkbox or radio element only if its checked state differs from the checked,
+// so that calling it again won't toggle the element back. The click triggers the input and change events.
+// A checked radio can't be unchecked by clicking it, check another radio of the same group instead,
+// an error will be returned if the state still differs after the click.
+func (el *Element) SetChecked(checked bool) error {
+	current, err := el.Checked()
+	if err != nil {
+		return err
+	}
+	if current == checked {
+		return nil
+	}
+
+	err = el.Click(proto.InputMouseButtonLeft, 1)
+	if err != nil {
+		return err
+	}
+
+	current, err = el.Checked()
+	if err != nil {
+		return err
+	}
+	if current != checked {
+		return fmt.Errorf("failed to set the checked state to %v by clicking the element", checked)
+	}
+	return nil
+}
+
 // SetFiles of the current file input element.
+// It returns an error if any of the paths doesn't exist or is a directory.
 func (el *Element) SetFiles(paths []string) error {
 	absPaths := utils.AbsolutePaths(paths)
 
+	for _, p := range absPaths {
+		info, err := os.Stat(p)
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return fmt.Errorf("can't set a directory as the file: %s", p)
+		}
+	}
+
 	defer el.tryTrace(TraceTypeInput, fmt.Sprintf("set files: %v", absPaths))()
 	el.page.browser.trySlowMotion()
 
@@ -418,6 +820,34 @@ func (el *Element) SetFiles(paths []string) error {
 	return err
 }
 
+// SetFilesFromReader sets the content of r as the file of the current file input element, the name is the
+// file name the page will see. The content is written to a temp file, because the browser reads the file lazily,
+// call the returned remove function to delete the temp file after the page has consumed it, such as the form is submitted.
+func (el *Element) SetFilesFromReader(name string, r io.Reader) (remove func(), err error) {
+	dir, err := os.MkdirTemp("", "rod-upload-")
+	if err != nil {
+		return nil, err
+	}
+	remove = func() { _ = os.RemoveAll(dir) }
+
+	path := filepath.Join(dir, filepath.Base(name))
+
+	f, err := os.Create(path)
+	if err == nil {
+		_, err = io.Copy(f, r)
+		_ = f.Close()
+	}
+	if err == nil {
+		err = el.SetFiles([]string{path})
+	}
+	if err != nil {
+		remove()
+		return nil, err
+	}
+
+	return remove, nil
+}
+
 // Describe the current element. The depth is the maximum depth at which children should be retrieved, defaults to 1,
 // use -1 for the entire subtree or provide an integer larger than 0.
 // The pierce decides whether or not iframes and shadow roots should be traversed when returning the subtree.
@@ -433,7 +863,18 @@ func (el *Element) Describe(depth int, pierce bool) (*proto.DOMNode, error) {
 	return val.Node, nil
 }
 
+// HasShadowRoot returns true if the element has a shadow root.
+func (el *Element) HasShadowRoot() (bool, error) {
+	node, err := el.Describe(1, false)
+	if err != nil {
+		return false, err
+	}
+	return len(node.ShadowRoots) > 0, nil
+}
+
 // ShadowRoot returns the shadow root of this element.
+// If the element has no shadow root, a [*NoShadowRootError] will be returned, use [Element.HasShadowRoot] to check it first.
+// Unlike "this.shadowRoot" in js, the closed shadow roots are also accessible.
 func (el *Element) ShadowRoot() (*Element, error) {
 	node, err := el.Describe(1, false)
 	if err != nil {
@@ -479,7 +920,9 @@ func (el *Element) ContainsElement(target *Element) (bool, error) {
 	return res.Value.Bool(), nil
 }
 
-// Text that the element displays.
+// Text that the element displays. For most elements it's the innerText, which is layout-aware,
+// such as the text of the "display: none" children will be excluded.
+// Use [Element.TextContent] to get the raw text in the DOM.
 func (el *Element) Text() (string, error) {
 	str, err := el.Evaluate(evalHelper(js.Text))
 	if err != nil {
@@ -488,6 +931,17 @@ func (el *Element) Text() (string, error) {
 	return str.Value.String(), nil
 }
 
+// TextContent of the element, it's the raw text of the element and all its descendants,
+// the text of the hidden descendants is included.
+// Doc: https://developer.mozilla.org/en-US/docs/Web/API/Node/textContent
+func (el *Element) TextContent() (string, error) {
+	res, err := el.Eval(`() => this.textContent`)
+	if err != nil {
+		return "", err
+	}
+	return res.Value.Str(), nil
+}
+
 // HTML of the element.
 func (el *Element) HTML() (string, error) {
 	res, err := proto.DOMGetOuterHTML{ObjectID: el.Object.ObjectID}.Call(el)
@@ -499,14 +953,16 @@ func (el *Element) HTML() (string, error) {
 
 // Visible returns true if the element is visible on the page.
 func (el *Element) Visible() (bool, error) {
-	res, err := el.Evaluate(evalHelper(js.Visible))
+	res, err := el.evalUtility(evalHelper(js.Visible))
 	if err != nil {
 		return false, err
 	}
 	return res.Value.Bool(), nil
 }
 
-// WaitLoad for element like <img>.
+// WaitLoad for element like <img>, <video> or <audio>. It waits until the image is complete
+// or the media has the data of the current frame, and returns an error if the resource fails to load.
+// For <iframe> use [Element.Frame] and [Page.WaitLoad] instead.
 func (el *Element) WaitLoad() error {
 	defer el.tryTrace(TraceTypeWait, "load")()
 	_, err := el.Evaluate(evalHelper(js.WaitLoad).ByPromise())
@@ -550,6 +1006,108 @@ func (el *Element) WaitStable(d time.Duration) error {
 	return nil
 }
 
+// WaitStableFull is similar to [Element.WaitStable], but it also waits until no change of the
+// computed opacity, transform, and visibility of the element. It's useful for the element that
+// fades in or transforms without changing its box.
+func (el *Element) WaitStableFull(d time.Duration) error {
+	err := el.WaitVisible()
+	if err != nil {
+		return err
+	}
+
+	defer el.tryTrace(TraceTypeWait, "stable full")()
+
+	shape, err := el.Shape()
+	if err != nil {
+		return err
+	}
+
+	style, err := el.stableStyle()
+	if err != nil {
+		return err
+	}
+
+	t := time.NewTicker(d)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-t.C:
+		case <-el.ctx.Done():
+			return el.ctx.Err()
+		}
+		current, err := el.Shape()
+		if err != nil {
+			return err
+		}
+		currentStyle, err := el.stableStyle()
+		if err != nil {
+			return err
+		}
+		if reflect.DeepEqual(shape, current) && style == currentStyle {
+			break
+		}
+		shape = current
+		style = currentStyle
+	}
+	return nil
+}
+
+// the computed styles that may change the appearance of the element without changing its shape
+func (el *Element) stableStyle() (string, error) {
+	res, err := el.Eval(`() => {
+		const s = getComputedStyle(this)
+		return [s.opacity, s.transform, s.visibility].join(';')
+	}`)
+	if err != nil {
+		return "", err
+	}
+	return res.Value.Str(), nil
+}
+
+// WaitStableRetry is similar to [Element.WaitStable], but the element is considered stable only after
+// the shape stays the same for the number of consecutive checks, and it will stop checking after maxTries
+// checks with a [NotStableError]. It's useful to avoid waiting forever for an element that animates forever.
+func (el *Element) WaitStableRetry(d time.Duration, times, maxTries int) error {
+	err := el.WaitVisible()
+	if err != nil {
+		return err
+	}
+
+	defer el.tryTrace(TraceTypeWait, "stable retry")()
+
+	shape, err := el.Shape()
+	if err != nil {
+		return err
+	}
+
+	t := time.NewTicker(d)
+	defer t.Stop()
+
+	count := 0
+	for i := 0; i < maxTries; i++ {
+		select {
+		case <-t.C:
+		case <-el.ctx.Done():
+			return el.ctx.Err()
+		}
+		current, err := el.Shape()
+		if err != nil {
+			return err
+		}
+		if reflect.DeepEqual(shape, current) {
+			count++
+			if count >= times {
+				return nil
+			}
+		} else {
+			count = 0
+		}
+		shape = current
+	}
+	return &NotStableError{el, maxTries}
+}
+
 // WaitStableRAF waits until no shape or position change for 2 consecutive animation frames.
 // If you want to wait animation that is triggered by JS not CSS, you'd better use [Element.WaitStable].
 // About animation frame: https://developer.mozilla.org/en-US/docs/Web/API/window/requestAnimationFrame
@@ -584,9 +1142,12 @@ func (el *Element) WaitStableRAF() error {
 
 // WaitInteractable waits for the element to be interactable.
 // It will try to scroll to the element on each try.
+// If it gives up while the element is still covered, such as by a transparent overlay,
+// the returned error will also wrap the [*CoveredError] that tells which element intercepts the cursor.
 func (el *Element) WaitInteractable() (pt *proto.Point, err error) {
 	defer el.tryTrace(TraceTypeWait, "interactable")()
 
+	var covered error
 	err = utils.Retry(el.ctx, el.sleeper(), func() (bool, error) {
 		// For lazy loading page the element can be outside of the viewport.
 		// If we don't scroll to it, it will never be available.
@@ -597,10 +1158,15 @@ func (el *Element) WaitInteractable() (pt *proto.Point, err error) {
 
 		pt, err = el.Interactable()
 		if errors.Is(err, &CoveredError{}) {
+			covered = err
 			return false, nil
 		}
+		covered = nil
 		return true, err
 	})
+	if err != nil && covered != nil {
+		err = fmt.Errorf("%w: %w", err, covered)
+	}
 	return
 }
 
@@ -612,7 +1178,9 @@ func (el *Element) Wait(opts *EvalOptions) error {
 // WaitVisible until the element is visible.
 func (el *Element) WaitVisible() error {
 	defer el.tryTrace(TraceTypeWait, "visible")()
-	return el.Wait(evalHelper(js.Visible))
+	return utils.Retry(el.ctx, el.sleeper(), func() (bool, error) {
+		return el.Visible()
+	})
 }
 
 // WaitEnabled until the element is not disabled.
@@ -632,7 +1200,10 @@ func (el *Element) WaitWritable() error {
 // WaitInvisible until the element invisible.
 func (el *Element) WaitInvisible() error {
 	defer el.tryTrace(TraceTypeWait, "invisible")()
-	return el.Wait(evalHelper(js.Invisible))
+	return utils.Retry(el.ctx, el.sleeper(), func() (bool, error) {
+		visible, err := el.Visible()
+		return !visible, err
+	})
 }
 
 // CanvasToImage get image data of a canvas.
@@ -649,6 +1220,23 @@ func (el *Element) CanvasToImage(format string, quality float64) ([]byte, error)
 	return bin, nil
 }
 
+// CanvasToImageWithMIME is similar to [Element.CanvasToImage], but it also returns the MIME type of the image,
+// because the browser will silently fallback to image/png if the format is not supported.
+// The quality must be within 0 and 1, it only takes effect for lossy formats, such as image/jpeg and image/webp.
+func (el *Element) CanvasToImageWithMIME(format string, quality float64) ([]byte, string, error) {
+	if quality < 0 || quality > 1 {
+		return nil, "", fmt.Errorf("canvas image quality must be within 0 and 1, got %v", quality)
+	}
+
+	res, err := el.Eval(`(format, quality) => this.toDataURL(format, quality)`, format, quality)
+	if err != nil {
+		return nil, "", err
+	}
+
+	mime, bin := parseDataURI(res.Value.Str())
+	return bin, mime, nil
+}
+
 // Resource returns the "src" content of current element. Such as the jpg of <img src="a.jpg">.
 func (el *Element) Resource() ([]byte, error) {
 	src, err := el.Evaluate(evalHelper(js.Resource).ByPromise())
@@ -673,11 +1261,52 @@ func (el *Element) BackgroundImage() ([]byte, error) {
 
 // Screenshot of the area of the element.
 func (el *Element) Screenshot(format proto.PageCaptureScreenshotFormat, quality int) ([]byte, error) {
-	err := el.ScrollIntoView()
+	bin, box, err := el.screenshotViewport(format, quality)
 	if err != nil {
 		return nil, err
 	}
 
+	// TODO: proto.PageCaptureScreenshot has a Clip option, but it's buggy, so now we do in Go.
+	return utils.CropImage(bin, quality,
+		int(box.X),
+		int(box.Y),
+		int(box.Width),
+		int(box.Height),
+	)
+}
+
+// ScreenshotPadded is similar to [Element.Screenshot], but the area will be expanded by the padding
+// on all sides, such as to include the borders and shadows of the element.
+// The area is clamped to the viewport, so it never goes beyond the page. The padding can't be negative.
+func (el *Element) ScreenshotPadded(format proto.PageCaptureScreenshotFormat, quality, padding int) ([]byte, error) {
+	if padding < 0 {
+		return nil, fmt.Errorf("screenshot padding must not be negative, got %d", padding)
+	}
+
+	bin, box, err := el.screenshotViewport(format, quality)
+	if err != nil {
+		return nil, err
+	}
+
+	x := max(int(box.X)-padding, 0)
+	y := max(int(box.Y)-padding, 0)
+
+	// The right and bottom sides will be clamped by the crop.
+	return utils.CropImage(bin, quality,
+		x,
+		y,
+		int(box.X+box.Width)+padding-x,
+		int(box.Y+box.Height)+padding-y,
+	)
+}
+
+// screenshotViewport scrolls the element into view, then captures the viewport and returns the box of the element.
+func (el *Element) screenshotViewport(format proto.PageCaptureScreenshotFormat, quality int) ([]byte, *proto.DOMRect, error) {
+	err := el.ScrollIntoView()
+	if err != nil {
+		return nil, nil, err
+	}
+
 	opts := &proto.PageCaptureScreenshot{
 		Quality: gson.Int(quality),
 		Format:  format,
@@ -685,23 +1314,56 @@ func (el *Element) Screenshot(format proto.PageCaptureScreenshotFormat, quality
 
 	bin, err := el.page.Context(el.ctx).Screenshot(false, opts)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// so that it won't clip the css-transformed element
 	shape, err := el.Shape()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return bin, shape.Box(), nil
+}
+
+// ScreenshotFull is similar to [Element.Screenshot], but when the element is taller than the viewport,
+// it will temporarily resize the viewport to the full page, the same as [Page.Screenshot] with fullPage,
+// so that the whole element is captured in one image. The viewport will be restored even on error.
+// Because the page is captured in one shot, fixed or sticky children won't be duplicated.
+func (el *Element) ScreenshotFull(format proto.PageCaptureScreenshotFormat, quality int) ([]byte, error) {
+	err := el.ScrollIntoView()
 	if err != nil {
 		return nil, err
 	}
 
-	box := shape.Box()
+	res, err := el.Eval(`() => {
+		const r = this.getBoundingClientRect()
+		return { x: r.x + scrollX, y: r.y + scrollY, width: r.width, height: r.height, viewHeight: innerHeight }
+	}`)
+	if err != nil {
+		return nil, err
+	}
+
+	box := res.Value
+	if box.Get("height").Num() <= box.Get("viewHeight").Num() {
+		return el.Screenshot(format, quality)
+	}
+
+	opts := &proto.PageCaptureScreenshot{
+		Quality: gson.Int(quality),
+		Format:  format,
+	}
+
+	bin, err := el.page.Context(el.ctx).Screenshot(true, opts)
+	if err != nil {
+		return nil, err
+	}
 
-	// TODO: proto.PageCaptureScreenshot has a Clip option, but it's buggy, so now we do in Go.
 	return utils.CropImage(bin, quality,
-		int(box.X),
-		int(box.Y),
-		int(box.Width),
-		int(box.Height),
+		box.Get("x").Int(),
+		box.Get("y").Int(),
+		box.Get("width").Int(),
+		box.Get("height").Int(),
 	)
 }
 
@@ -734,6 +1396,40 @@ func (el *Element) Evaluate(opts *EvalOptions) (*proto.RuntimeRemoteObject, erro
 	return el.page.Context(el.ctx).Evaluate(opts.This(el.Object))
 }
 
+// evalUtility is similar to [Element.Evaluate], but the js runs in the utility world of the page,
+// so the page can't fool the result by tampering with the built-ins. The element is resolved in the
+// world by its backend node id, so the js should return a primitive value instead of a remote object.
+func (el *Element) evalUtility(opts *EvalOptions) (*proto.RuntimeRemoteObject, error) {
+	page := el.page.Context(el.ctx)
+
+	node, err := el.Describe(0, false)
+	if err != nil {
+		return nil, err
+	}
+
+	resolve := func() (*utilityWorld, *proto.DOMResolveNodeResult, error) {
+		w, err := page.utilityWorld()
+		if err != nil {
+			return nil, nil, err
+		}
+		obj, err := proto.DOMResolveNode{BackendNodeID: node.BackendNodeID, ExecutionContextID: w.ctxID}.Call(el)
+		return w, obj, err
+	}
+
+	w, obj, err := resolve()
+	if errors.Is(err, cdp.ErrCtxNotFound) {
+		// the world is destroyed with its document, such as after a reload, create a new one
+		page.utility.drop(w)
+		w, obj, err = resolve()
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = w.page.Release(obj.Object) }()
+
+	return w.page.Context(el.ctx).Evaluate(opts.This(obj.Object))
+}
+
 // Equal checks if the two elements are equal.
 func (el *Element) Equal(elm *Element) (bool, error) {
 	res, err := el.Eval(`elm => this === elm`, elm.Object)
@@ -744,7 +1440,20 @@ func (el *Element) id() proto.RuntimeRemoteObjectID {
 	return el.Object.ObjectID
 }
 
-// GetXPath returns the xpath of the element.
+// GetSelector returns a css selector path of the element, such as "#list > li:nth-child(2)".
+// It starts from the nearest ancestor that has an id, or the root element if there's none.
+// It's useful for logging which element an action targeted, or generating code from manual exploration.
+func (el *Element) GetSelector() (string, error) {
+	str, err := el.Evaluate(evalHelper(js.GetSelector))
+	if err != nil {
+		return "", err
+	}
+	return str.Value.String(), nil
+}
+
+// GetXPath returns the xpath of the element, it can be used with [Page.ElementX] to select the element again.
+// If optimized is true, the path will start from the nearest ancestor that has an id, such as "//*[@id='a']/div[2]",
+// or the path will always start from the root, such as "/html/body/div[2]".
 func (el *Element) GetXPath(optimized bool) (string, error) {
 	str, err := el.Evaluate(evalHelper(js.GetXPath, optimized))
 	if err != nil {
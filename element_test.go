@@ -2,15 +2,19 @@ package rod_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"image/color"
+	"image/jpeg"
 	"image/png"
 	"net"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+	"testing/iotest"
 	"time"
 
 	"github.com/yontaruron/rod"
@@ -49,6 +53,31 @@ func TestClick(t *testing.T) {
 	})
 }
 
+func TestRightClick(t *testing.T) {
+	g := setup(t)
+
+	p := g.newPage().MustNavigate(g.srcFile("fixtures/click.html"))
+	el := p.MustElement("button")
+	el.MustEval(`() => this.oncontextmenu = (e) => this.dataset['prevented'] = e.defaultPrevented`)
+
+	el.MustRightClick()
+	g.Eq("false", el.MustEval(`() => this.dataset['prevented']`).Str())
+
+	remove := p.MustPreventContextMenu()
+	el.MustRightClick()
+	g.Eq("true", el.MustEval(`() => this.dataset['prevented']`).Str())
+
+	remove()
+	el.MustRightClick()
+	g.Eq("false", el.MustEval(`() => this.dataset['prevented']`).Str())
+
+	g.mc.stubErr(1, proto.RuntimeCallFunctionOn{})
+	g.Err(p.PreventContextMenu())
+
+	g.mc.stubErr(1, proto.PageAddScriptToEvaluateOnNewDocument{})
+	g.Err(p.PreventContextMenu())
+}
+
 func TestClickWrapped(t *testing.T) {
 	g := setup(t)
 
@@ -194,6 +223,17 @@ func TestWaitInteractable(t *testing.T) {
 
 	g.mc.stubErr(1, proto.DOMScrollIntoViewIfNeeded{})
 	g.Err(el.WaitInteractable())
+
+	p.MustEval(`() => {
+		let div = document.createElement('div')
+		div.style = 'position: absolute; left: 0; top: 0; width: 500px; height: 500px; opacity: 0;'
+		document.body.append(div)
+	}`)
+	_, err := el.Timeout(time.Second).WaitInteractable()
+	g.Is(err, context.DeadlineExceeded)
+	g.Is(err, &rod.CoveredError{})
+	g.Has(err.Error(), "element covered by: <div>")
+	g.Is(el.Timeout(time.Second).Click(proto.InputMouseButtonLeft, 1), &rod.CoveredError{})
 }
 
 func TestHover(t *testing.T) {
@@ -218,6 +258,118 @@ func TestHover(t *testing.T) {
 	g.Err(el.Hover())
 }
 
+func TestMoveMouseTo(t *testing.T) {
+	g := setup(t)
+
+	p := g.page.MustNavigate(g.srcFile("fixtures/click.html"))
+	el := p.MustElement("button")
+	el.MustEval(`() => this.onmouseenter = () => this.dataset['a'] = 1`)
+
+	pt := el.MustMoveMouseTo()
+	g.Eq("1", el.MustEval(`() => this.dataset['a']`).String())
+	g.Eq(pt, p.Mouse.Position())
+
+	box := el.MustShape().Box()
+	g.Eq(pt, proto.NewPoint(box.X+box.Width/2, box.Y+box.Height/2))
+
+	g.mc.stubErr(1, proto.DOMScrollIntoViewIfNeeded{})
+	g.Err(el.MoveMouseTo())
+
+	g.mc.stubErr(3, proto.DOMGetContentQuads{})
+	g.Err(el.MoveMouseTo())
+
+	g.mc.stubErr(1, proto.InputDispatchMouseEvent{})
+	g.Err(el.MoveMouseTo())
+
+	g.mc.stub(3, proto.DOMGetContentQuads{}, func(_ StubSend) (gson.JSON, error) {
+		return gson.New(proto.DOMGetContentQuadsResult{}), nil
+	})
+	_, err := el.MoveMouseTo()
+	g.Is(err, &rod.InvisibleShapeError{})
+}
+
+func TestDragTo(t *testing.T) {
+	g := setup(t)
+
+	p := g.newPage().MustNavigate(g.srcFile("fixtures/drag.html")).MustWaitLoad()
+	from := p.MustElement(".dropzone:nth-child(2)")
+	to := p.MustElement(".dropzone")
+
+	from.MustDragTo(to)
+	track := p.MustEval(`() => dragTrack`).Str()
+	pt := p.Mouse.Position()
+	g.Has(track, " down ")
+	g.Has(track, fmt.Sprintf(" up %d %d", int(pt.X), int(pt.Y)))
+
+	// the html5 drag-and-drop
+	p.MustElement("#draggable").MustDragTo(from)
+	p.MustElement(".dropzone:nth-child(2) #draggable")
+
+	g.mc.stubErr(1, proto.RuntimeCallFunctionOn{})
+	g.Err(from.DragTo(to))
+
+	g.mc.stubErr(1, proto.DOMScrollIntoViewIfNeeded{})
+	g.Err(from.DragTo(to))
+
+	g.mc.stubErr(2, proto.InputDispatchMouseEvent{})
+	g.Err(from.DragTo(to))
+
+	g.mc.stubErr(2, proto.DOMScrollIntoViewIfNeeded{})
+	g.Err(from.DragTo(to))
+
+	g.mc.stubErr(4, proto.InputDispatchMouseEvent{})
+	g.Err(from.DragTo(to))
+
+	drag := p.MustElement("#draggable")
+	g.mc.stubErr(1, proto.DOMScrollIntoViewIfNeeded{})
+	g.Err(drag.DragTo(to))
+
+	g.mc.stubErr(2, proto.DOMScrollIntoViewIfNeeded{})
+	g.Err(drag.DragTo(to))
+}
+
+func TestHoverLinear(t *testing.T) {
+	g := setup(t)
+
+	p := g.page.MustNavigate(g.srcFile("fixtures/click.html"))
+	el := p.MustElement("button")
+	el.MustEval(`() => {
+		this.dataset['n'] = 0
+		this.onmousemove = () => this.dataset['n']++
+	}`)
+	p.Mouse.MustMoveTo(0, 0)
+	el.MustHoverLinear(6)
+	g.Gt(el.MustEval(`() => Number(this.dataset['n'])`).Int(), 0)
+
+	// the steps less than 1 are treated as 1
+	p.Mouse.MustMoveTo(0, 0)
+	el.MustHoverLinear(0)
+	el.MustHoverLinear(-1)
+
+	g.mc.stubErr(1, proto.InputDispatchMouseEvent{})
+	g.Err(el.HoverLinear(3))
+}
+
+func TestScrollIntoViewAligned(t *testing.T) {
+	g := setup(t)
+
+	p := g.page.MustNavigate(g.srcFile("fixtures/scroll-y.html")).MustWaitLoad()
+	el := p.MustElement("button")
+
+	top := func() float64 {
+		return el.MustEval(`() => this.getBoundingClientRect().top`).Num()
+	}
+
+	el.MustScrollIntoViewAligned(rod.ScrollAlignmentStart, "")
+	g.Eq(top(), 0.0)
+
+	el.MustScrollIntoViewAligned(rod.ScrollAlignmentCenter, rod.ScrollAlignmentNearest)
+	g.Gt(top(), 0.0)
+
+	g.mc.stubErr(1, proto.RuntimeCallFunctionOn{})
+	g.Err(el.ScrollIntoViewAligned(rod.ScrollAlignmentEnd, ""))
+}
+
 func TestElementMoveMouseOut(t *testing.T) {
 	g := setup(t)
 
@@ -333,6 +485,20 @@ func TestShadowDOM(t *testing.T) {
 	_, err := elNoShadow.ShadowRoot()
 	g.True((&rod.NoShadowRootError{}).Is(err))
 	g.Has(err.Error(), "element has no shadow root:")
+
+	g.True(el.MustHasShadowRoot())
+	g.False(elNoShadow.MustHasShadowRoot())
+	g.mc.stubErr(1, proto.DOMDescribeNode{})
+	g.Err(el.HasShadowRoot())
+
+	closed := p.MustElementByJS(`() => {
+		const host = document.createElement('div')
+		host.attachShadow({ mode: 'closed' }).innerHTML = '<p>closed</p>'
+		document.body.appendChild(host)
+		return host
+	}`)
+	g.Nil(closed.MustEval(`() => this.shadowRoot`).Val())
+	g.Eq("closed", closed.MustShadowRoot().MustElement("p").MustText())
 }
 
 func TestInputTime(t *testing.T) {
@@ -470,6 +636,68 @@ func TestSelectText(t *testing.T) {
 	})
 }
 
+func TestClear(t *testing.T) {
+	g := setup(t)
+
+	p := g.page.MustNavigate(g.srcFile("fixtures/input.html"))
+
+	el := p.MustElement("[type=text]").MustInput("abc")
+	el.MustEval(`() => this.oninput = () => this.dataset['cleared'] = this.value === ''`)
+	el.MustClear()
+	g.Eq("", el.MustProperty("value").Str())
+	g.Eq("true", *el.MustAttribute("data-cleared"))
+
+	area := p.MustElement("textarea").MustInput("abc\ndef")
+	g.Eq("", area.MustClear().MustProperty("value").Str())
+
+	div := p.MustElement("[contenteditable]").MustInput("abc")
+	g.Eq("", div.MustClear().MustText())
+
+	div.MustEval(`() => {
+		this.innerHTML = '<p>a<b>b</b></p><div><br></div>'
+		this.oninput = () => this.dataset['cleared'] = 'true'
+	}`)
+	div.MustClear()
+	g.Eq(div.MustEval(`() => this.innerHTML`).Str(), "")
+	g.Eq("true", *div.MustAttribute("data-cleared"))
+
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.DOMScrollIntoViewIfNeeded{})
+		el.MustClear()
+	})
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.InputDispatchKeyEvent{})
+		el.MustClear()
+	})
+}
+
+func TestInputClear(t *testing.T) {
+	g := setup(t)
+
+	p := g.page.MustNavigate(g.srcFile("fixtures/input.html"))
+	el := p.MustElement("textarea")
+	el.MustInput("abc")
+	el.MustInputClear("test")
+	g.Eq("test", el.MustText())
+
+	num := p.MustElement("[type=number]")
+	num.MustInput("12")
+	num.MustEval(`() => this.dataset['inputs'] = 0`)
+	num.MustInputClear("34")
+	g.Eq("34", num.MustProperty("value").Str())
+	g.True(num.MustEval(`() => this.validity.valid`).Bool())
+	g.Eq("1", *num.MustAttribute("data-inputs"))
+
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.DOMScrollIntoViewIfNeeded{})
+		el.MustInputClear("")
+	})
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.InputInsertText{})
+		el.MustInputClear("")
+	})
+}
+
 func TestBlur(t *testing.T) {
 	g := setup(t)
 
@@ -518,6 +746,40 @@ func TestSelectOptions(t *testing.T) {
 	}
 }
 
+func TestSelectBy(t *testing.T) {
+	g := setup(t)
+
+	p := g.page.MustNavigate(g.html(`<select multiple>
+		<option value="a">Banana split</option>
+		<option value="b">Banana</option>
+		<option>C (1)</option>
+	</select>
+	<script>
+		window.changes = 0
+		document.querySelector('select').onchange = () => window.changes++
+	</script>`))
+	el := p.MustElement("select")
+	selected := func() string {
+		return el.MustEval(`() => Array.from(this.selectedOptions).map(o => o.index).join()`).Str()
+	}
+
+	el.MustSelectByText("Banana", "C (1)")
+	g.Eq(selected(), "1,2")
+	g.Eq(p.MustEval(`() => changes`).Int(), 1)
+
+	el.MustEval(`() => this.selectedIndex = -1`)
+	el.MustSelectByValue("a", "C (1)")
+	g.Eq(selected(), "0,2")
+
+	el.MustEval(`() => this.selectedIndex = -1`)
+	el.MustSelectByIndex(1)
+	g.Eq(selected(), "1")
+
+	g.Is(el.SelectByText("Ban"), &rod.ElementNotFoundError{})
+	g.Is(el.SelectByValue("x"), &rod.ElementNotFoundError{})
+	g.Is(el.SelectByIndex(3), &rod.ElementNotFoundError{})
+}
+
 func TestMatches(t *testing.T) {
 	g := setup(t)
 
@@ -554,6 +816,32 @@ func TestAttribute(t *testing.T) {
 	})
 }
 
+func TestSetAttribute(t *testing.T) {
+	g := setup(t)
+
+	p := g.page.MustNavigate(g.srcFile("fixtures/click.html"))
+	el := p.MustElement("button")
+
+	el.MustSetAttribute("data-test-state", "ready")
+	g.Eq("ready", *el.MustAttribute("data-test-state"))
+
+	el.MustSetAttribute("class", "a b")
+	g.True(el.MustMatches(".a.b"))
+
+	el.MustSetAttribute("style", "display: none")
+	g.False(el.MustVisible())
+
+	el.MustRemoveAttribute("style").MustRemoveAttribute("data-test-state")
+	g.Nil(el.MustAttribute("data-test-state"))
+	g.True(el.MustVisible())
+
+	g.mc.stubErr(1, proto.RuntimeCallFunctionOn{})
+	g.Err(el.SetAttribute("a", "b"))
+
+	g.mc.stubErr(1, proto.RuntimeCallFunctionOn{})
+	g.Err(el.RemoveAttribute("a"))
+}
+
 func TestProperty(t *testing.T) {
 	g := setup(t)
 
@@ -578,6 +866,46 @@ func TestProperty(t *testing.T) {
 	})
 }
 
+func TestElementComputedStyle(t *testing.T) {
+	g := setup(t)
+
+	p := g.page.MustNavigate(g.html(`<div style="color: red; font-size: 2em; display: inline">ok</div>`))
+	el := p.MustElement("div")
+
+	g.Eq(el.MustComputedStyle("color", "font-size", "display"), map[string]string{
+		"color":     "rgb(255, 0, 0)",
+		"font-size": "32px",
+		"display":   "inline",
+	})
+
+	all := el.MustComputedStyle()
+	g.Gt(len(all), 100)
+	g.Eq(all["color"], "rgb(255, 0, 0)")
+
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.RuntimeCallFunctionOn{})
+		el.MustComputedStyle()
+	})
+}
+
+func TestSetProperty(t *testing.T) {
+	g := setup(t)
+
+	p := g.page.MustNavigate(g.srcFile("fixtures/input.html"))
+
+	el := p.MustElement("[type=checkbox]").MustSetProperty("checked", true)
+	g.True(el.MustProperty("checked").Bool())
+
+	el = p.MustElement("textarea").MustSetProperty("value", "abc")
+	g.Eq("abc", el.MustText())
+
+	el.MustSetProperty("custom", map[string]int{"a": 1})
+	g.Eq(1, el.MustProperty("custom").Get("a").Int())
+
+	err := el.SetProperty("tagName", "div")
+	g.Is(err, &rod.EvalError{})
+}
+
 func TestDisabled(t *testing.T) {
 	g := setup(t)
 
@@ -593,6 +921,62 @@ func TestDisabled(t *testing.T) {
 	})
 }
 
+func TestElementChecked(t *testing.T) {
+	g := setup(t)
+
+	p := g.page.MustNavigate(g.html(`<input type="checkbox" id="a" checked><input type="radio" id="b">
+		<select><option id="c">c</option><option id="d" selected>d</option></select>`))
+
+	g.True(p.MustElement("#a").MustChecked())
+	g.False(p.MustElement("#b").MustChecked())
+	g.True(p.MustElement("#b").MustClick().MustChecked())
+
+	g.False(p.MustElement("#c").MustSelected())
+	g.True(p.MustElement("#d").MustSelected())
+
+	// a non-checkable element is never checked
+	g.False(p.MustElement("select").MustChecked())
+
+	el := p.MustElement("#a")
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.RuntimeCallFunctionOn{})
+		el.MustChecked()
+	})
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.RuntimeCallFunctionOn{})
+		el.MustSelected()
+	})
+}
+
+func TestElementSetChecked(t *testing.T) {
+	g := setup(t)
+
+	p := g.page.MustNavigate(g.html(`<input type="checkbox" id="a">
+		<input type="radio" name="r" id="b"><input type="radio" name="r" id="c">
+		<script>
+			window.changes = 0
+			document.querySelector('#a').onchange = () => window.changes++
+		</script>`))
+
+	a := p.MustElement("#a")
+	g.True(a.MustSetChecked(true).MustChecked())
+	g.True(a.MustSetChecked(true).MustChecked())
+	g.Eq(p.MustEval(`() => changes`).Int(), 1)
+	g.False(a.MustSetChecked(false).MustChecked())
+	g.Eq(p.MustEval(`() => changes`).Int(), 2)
+
+	b := p.MustElement("#b")
+	g.True(b.MustSetChecked(true).MustSetChecked(true).MustChecked())
+	g.Err(b.SetChecked(false))
+	p.MustElement("#c").MustSetChecked(true)
+	g.False(b.MustChecked())
+
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.RuntimeCallFunctionOn{})
+		a.MustSetChecked(true)
+	})
+}
+
 func TestSetFiles(t *testing.T) {
 	g := setup(t)
 
@@ -608,6 +992,33 @@ func TestSetFiles(t *testing.T) {
 	g.Eq("alert.html", list[1].String())
 }
 
+func TestSetFilesErr(t *testing.T) {
+	g := setup(t)
+
+	p := g.page.MustNavigate(g.srcFile("fixtures/input.html"))
+	el := p.MustElement(`[type=file]`)
+
+	g.Err(el.SetFiles([]string{slash("fixtures/not-exists.html")}))
+	g.Err(el.SetFiles([]string{slash("fixtures")}))
+	g.Len(el.MustEval("() => this.files").Arr(), 0)
+}
+
+func TestSetFilesFromReader(t *testing.T) {
+	g := setup(t)
+
+	p := g.page.MustNavigate(g.srcFile("fixtures/input.html"))
+	el := p.MustElement(`[type=file]`)
+
+	remove := el.MustSetFilesFromReader("data.txt", strings.NewReader("generated"))
+	defer remove()
+
+	g.Eq("data.txt", el.MustEval("() => this.files[0].name").Str())
+	g.Eq("generated", el.MustEval("() => this.files[0].text()").Str())
+
+	_, err := el.SetFilesFromReader("x.txt", iotest.ErrReader(errors.New("read")))
+	g.Eq("read", err.Error())
+}
+
 func TestEnter(t *testing.T) {
 	g := setup(t)
 
@@ -689,6 +1100,73 @@ func TestWaitStable(t *testing.T) {
 	})
 }
 
+func TestWaitStableFull(t *testing.T) {
+	g := setup(t)
+
+	p := g.page.MustNavigate(g.srcFile("fixtures/wait-stable.html"))
+	el := p.MustElement("button")
+	el.MustEval(`() => this.className = "fade"`)
+	go func() {
+		utils.Sleep(1)
+		el.MustEval(`() => this.classList.remove("fade")`)
+	}()
+	start := time.Now()
+	el.MustWaitStableFull()
+	g.Gt(time.Since(start), time.Second)
+
+	ctx := g.Context()
+	ctx.Cancel()
+	g.Err(el.Context(ctx).WaitStableFull(time.Minute))
+
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.DOMGetContentQuads{})
+		el.MustWaitStableFull()
+	})
+	g.Panic(func() {
+		g.mc.stubErr(2, proto.DOMGetContentQuads{})
+		el.MustWaitStableFull()
+	})
+	g.Panic(func() {
+		g.mc.stubErr(2, proto.RuntimeCallFunctionOn{})
+		el.MustWaitStableFull()
+	})
+	g.Panic(func() {
+		g.mc.stubErr(3, proto.RuntimeCallFunctionOn{})
+		el.MustWaitStableFull()
+	})
+}
+
+func TestWaitStableRetry(t *testing.T) {
+	g := setup(t)
+
+	p := g.page.MustNavigate(g.srcFile("fixtures/wait-stable.html"))
+	el := p.MustElement("button")
+
+	err := el.WaitStableRetry(50*time.Millisecond, 2, 5)
+	g.Is(err, &rod.NotStableError{})
+	g.Has(err.Error(), "element is still changing after 5 checks:")
+
+	el.MustEval(`() => this.classList.remove("play")`)
+	el.MustWaitStableRetry(2, 5)
+
+	ctx := g.Context()
+	ctx.Cancel()
+	g.Err(el.Context(ctx).WaitStableRetry(time.Minute, 2, 5))
+
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.DOMGetContentQuads{})
+		el.MustWaitStableRetry(2, 5)
+	})
+	g.Panic(func() {
+		g.mc.stubErr(2, proto.DOMGetContentQuads{})
+		el.MustWaitStableRetry(2, 5)
+	})
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.RuntimeCallFunctionOn{})
+		el.MustWaitStableRetry(2, 5)
+	})
+}
+
 func TestWaitStableRAP(t *testing.T) {
 	g := setup(t)
 
@@ -718,6 +1196,31 @@ func TestCanvasToImage(t *testing.T) {
 	g.Eq(src.At(50, 50), color.NRGBA{0xFF, 0x00, 0x00, 0xFF})
 }
 
+func TestCanvasToImageWithMIME(t *testing.T) {
+	g := setup(t)
+
+	p := g.page.MustNavigate(g.srcFile("fixtures/canvas.html"))
+	el := p.MustElement("#canvas")
+
+	bin, mime := el.MustCanvasToImageWithMIME("image/jpeg", 0.5)
+	g.Eq(mime, "image/jpeg")
+	src, err := jpeg.Decode(bytes.NewBuffer(bin))
+	g.E(err)
+	g.Gt(src.Bounds().Dx(), 0)
+
+	// fallback to png for unsupported format
+	_, mime = el.MustCanvasToImageWithMIME("image/unknown", 1)
+	g.Eq(mime, "image/png")
+
+	_, _, err = el.CanvasToImageWithMIME("image/jpeg", 1.5)
+	g.Eq(err.Error(), "canvas image quality must be within 0 and 1, got 1.5")
+
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.RuntimeCallFunctionOn{})
+		el.MustCanvasToImageWithMIME("", 0)
+	})
+}
+
 func TestElementWaitLoad(t *testing.T) {
 	g := setup(t)
 
@@ -725,6 +1228,13 @@ func TestElementWaitLoad(t *testing.T) {
 	p.MustElement("img").MustWaitLoad()
 }
 
+func TestElementWaitLoadMedia(t *testing.T) {
+	g := setup(t)
+
+	p := g.page.MustNavigate(g.html(`<video src="/not-exists.mp4"></video>`))
+	g.Err(p.MustElement("video").WaitLoad())
+}
+
 func TestResource(t *testing.T) {
 	g := setup(t)
 
@@ -791,6 +1301,65 @@ func TestElementScreenshot(t *testing.T) {
 	})
 }
 
+func TestElementScreenshotPadded(t *testing.T) {
+	g := setup(t)
+
+	p := g.page.MustNavigate(g.srcFile("fixtures/click.html"))
+	el := p.MustElement("h4")
+
+	img, err := png.Decode(bytes.NewBuffer(el.MustScreenshotPadded(5)))
+	g.E(err)
+	g.Eq(210, img.Bounds().Dx())
+	g.Eq(40, img.Bounds().Dy())
+
+	// the left side is clamped to the viewport
+	x := int(el.MustShape().Box().X)
+	img, err = png.Decode(bytes.NewBuffer(el.MustScreenshotPadded(x + 10)))
+	g.E(err)
+	g.Eq(x+200+x+10, img.Bounds().Dx())
+
+	g.mc.stubErr(1, proto.PageCaptureScreenshot{})
+	g.Err(el.ScreenshotPadded(proto.PageCaptureScreenshotFormatPng, 0, 5))
+
+	_, err = el.ScreenshotPadded(proto.PageCaptureScreenshotFormatPng, 0, -1)
+	g.Has(err.Error(), "padding must not be negative")
+}
+
+func TestElementScreenshotFull(t *testing.T) {
+	g := setup(t)
+
+	p := g.page.MustNavigate(g.srcFile("fixtures/scroll-y.html"))
+	el := p.MustElement("body")
+
+	img, err := png.Decode(bytes.NewBuffer(el.MustScreenshotFull()))
+	g.E(err)
+	res := el.MustEval(`() => ({w: this.offsetWidth, h: this.offsetHeight})`)
+	g.Eq(res.Get("w").Int(), img.Bounds().Dx())
+	g.Eq(res.Get("h").Int(), img.Bounds().Dy())
+
+	// the viewport should be restored
+	g.Eq(800, p.MustEval(`() => innerHeight`).Int())
+
+	// elements that fit in the viewport are the same as Element.Screenshot
+	img, err = png.Decode(bytes.NewBuffer(p.MustElement("button").MustScreenshotFull()))
+	g.E(err)
+	g.Eq(int(p.MustElement("button").MustShape().Box().Height), img.Bounds().Dy())
+
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.DOMScrollIntoViewIfNeeded{})
+		el.MustScreenshotFull()
+	})
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.RuntimeCallFunctionOn{})
+		el.MustScreenshotFull()
+	})
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.PageCaptureScreenshot{})
+		el.MustScreenshotFull()
+	})
+	g.Eq(800, p.MustEval(`() => innerHeight`).Int())
+}
+
 func TestUseReleasedElement(t *testing.T) {
 	g := setup(t)
 
@@ -877,6 +1446,20 @@ func TestElementOthers(t *testing.T) {
 	g.Len(el.MustElementsByJS(`() => []`), 0)
 }
 
+func TestElementTextContent(t *testing.T) {
+	g := setup(t)
+
+	p := g.page.MustNavigate(g.blank())
+	p.MustSetDocumentContent(`<div>a<span style="display: none">b</span></div>`)
+	el := p.MustElement("div")
+
+	g.Eq("a", el.MustText())
+	g.Eq("ab", el.MustTextContent())
+
+	g.mc.stubErr(1, proto.RuntimeCallFunctionOn{})
+	g.Err(el.TextContent())
+}
+
 func TestElementEqual(t *testing.T) {
 	g := setup(t)
 
@@ -916,6 +1499,18 @@ func TestShapeInIframe(t *testing.T) {
 	g.InDelta(pt.Y, 287, 1)
 }
 
+func TestElementFromPointOverlay(t *testing.T) {
+	g := setup(t)
+
+	p := g.page.MustNavigate(g.html(`
+		<button style="position: absolute; left: 10px; top: 10px; width: 50px; height: 50px">a</button>
+		<div id="overlay" style="position: absolute; left: 0; top: 0; width: 30px; height: 100px"></div>
+	`))
+
+	g.Eq(*p.MustElementFromPoint(20, 20).MustAttribute("id"), "overlay")
+	g.Eq(p.MustElementFromPoint(50, 20).MustText(), "a")
+}
+
 func TestElementFromPointErr(t *testing.T) {
 	g := setup(t)
 
@@ -984,6 +1579,23 @@ func TestElementErrors(t *testing.T) {
 	g.Err(err)
 }
 
+func TestElementGetSelector(t *testing.T) {
+	g := setup(t)
+
+	p := g.page.MustNavigate(g.html(`<ul id="a:b"><li>1</li><li>2</li></ul><div><p>3</p></div>`))
+
+	el := p.MustElement("li:nth-child(2)")
+	g.Eq(el.MustGetSelector(), `#a\:b > li:nth-child(2)`)
+	g.True(p.MustElement(el.MustGetSelector()).MustEqual(el))
+
+	el = p.MustElement("p")
+	g.Eq(el.MustGetSelector(), "html > body:nth-child(2) > div:nth-child(2) > p")
+	g.True(p.MustElement(el.MustGetSelector()).MustEqual(el))
+
+	g.mc.stubErr(1, proto.RuntimeCallFunctionOn{})
+	g.Err(el.GetSelector())
+}
+
 func TestElementGetXPath(t *testing.T) {
 	g := setup(t)
 
@@ -999,4 +1611,11 @@ func TestElementGetXPath(t *testing.T) {
 		g.mc.stubErr(1, proto.RuntimeCallFunctionOn{})
 		el.MustGetXPath(true)
 	})
+
+	p = g.page.MustNavigate(g.html(`<div id="a"><p>1</p><p>2</p></div>`))
+	el = p.MustElement("p:nth-child(2)")
+	g.Eq(el.MustGetXPath(true), `//*[@id='a']/p[2]`)
+	g.Eq(el.MustGetXPath(false), "/html/body/div/p[2]")
+	g.True(p.MustElementX(el.MustGetXPath(true)).MustEqual(el))
+	g.True(p.MustElementX(el.MustGetXPath(false)).MustEqual(el))
 }
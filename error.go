@@ -86,6 +86,9 @@ type EvalError struct {
 
 func (e *EvalError) Error() string {
 	exp := e.Exception
+	if exp == nil {
+		return "eval js error: " + e.Text
+	}
 	return fmt.Sprintf("eval js error: %s %s", exp.Description, exp.Value)
 }
 
@@ -179,6 +182,9 @@ func (e *PageNotFoundError) Error() string {
 	return "cannot find page"
 }
 
+// Is interface.
+func (e *PageNotFoundError) Is(err error) bool { _, ok := err.(*PageNotFoundError); return ok }
+
 // NoShadowRootError error.
 type NoShadowRootError struct {
 	*Element
@@ -191,3 +197,17 @@ func (e *NoShadowRootError) Error() string {
 
 // Is interface.
 func (e *NoShadowRootError) Is(err error) bool { _, ok := err.(*NoShadowRootError); return ok }
+
+// NotStableError error.
+type NotStableError struct {
+	*Element
+	Tries int
+}
+
+// Error ...
+func (e *NotStableError) Error() string {
+	return fmt.Sprintf("element is still changing after %d checks: %s", e.Tries, e.String())
+}
+
+// Is interface.
+func (e *NotStableError) Is(err error) bool { _, ok := err.(*NotStableError); return ok }
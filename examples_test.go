@@ -367,6 +367,12 @@ func Example_customize_retry_strategy() {
 	el, _ := page.Sleeper(sleeper).Element("input")
 	fmt.Println(el.MustProperty("name"))
 
+	// poll from 10ms and grow the interval 1.5x each time up to 200ms
+	fast := func() utils.Sleeper {
+		return utils.BackoffSleeper(10*time.Millisecond, 200*time.Millisecond, utils.ScaleBackoff(1.5))
+	}
+	page.Sleeper(fast).MustElement("input")
+
 	// If sleeper is nil page.ElementE will query without retrying.
 	// If nothing found it will return an error.
 	el, err := page.Sleeper(rod.NotFoundSleeper).Element("input")
@@ -27,6 +27,10 @@ func (b *Browser) HijackRequests() *HijackRouter {
 //	browser --req-> rod ---> server ---> rod --res-> browser
 //
 // The --req-> and --res-> are the parts that can be modified.
+// In a handler, use [Hijack.ContinueRequest] to continue the request, [HijackResponse.Fail] to abort it,
+// or set the [Hijack.Response] to fulfill it with custom status, headers, and body.
+// To modify the response from the server, call [Hijack.LoadResponse] first.
+// Use [HijackRouter.Stop] to disable the Fetch domain when the router is no longer needed.
 func (p *Page) HijackRequests() *HijackRouter {
 	return newHijackRouter(p.browser, p).initEvents()
 }
@@ -411,6 +415,8 @@ func (ctx *HijackResponse) Fail(reason proto.NetworkErrorReason) *HijackResponse
 
 // HandleAuth for the next basic HTTP authentication.
 // It will prevent the popup that requires user to input user name and password.
+// It also works for the 407 challenge of an authenticated proxy, such as the one set by launcher.Launcher.Proxy.
+// The handler must be registered before the navigation that triggers the authentication.
 // Ref: https://developer.mozilla.org/en-US/docs/Web/HTTP/Authentication
 func (b *Browser) HandleAuth(username, password string) func() error {
 	enable := b.DisableDomain("", &proto.FetchEnable{})
@@ -3,6 +3,7 @@ package rod
 import (
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/yontaruron/rod/lib/input"
 	"github.com/yontaruron/rod/lib/proto"
@@ -85,6 +86,69 @@ func (k *Keyboard) Type(keys ...input.Key) (err error) {
 	return
 }
 
+// Combo presses the keys in order, then releases them in reverse order, such as the shortcut ctrl+shift+k:
+//
+//	page.Keyboard.Combo(input.ControlLeft, input.ShiftLeft, 'k')
+//
+// The modifiers of the held keys will be set on the key events, so the page sees a real accelerator.
+func (k *Keyboard) Combo(keys ...input.Key) (err error) {
+	pressed := 0
+	defer func() {
+		for i := pressed - 1; i >= 0; i-- {
+			e := k.Release(keys[i])
+			if err == nil {
+				err = e
+			}
+		}
+	}()
+
+	for _, key := range keys {
+		err = k.Press(key)
+		if err != nil {
+			return
+		}
+		pressed++
+	}
+	return
+}
+
+// TypeText types the text rune by rune with the delay between them, each rune fires its own
+// keydown, keypress, and keyup events, so that the key listeners on the page fire as if a human is typing.
+// The shift key will be held for the runes that need it, such as 'A' and '!'.
+// The runes that are not on the keyboard, such as Chinese or Japanese, will be inserted like [Page.InsertText].
+func (k *Keyboard) TypeText(text string, delay time.Duration) error {
+	for i, r := range text {
+		if i > 0 && delay > 0 {
+			t := time.NewTimer(delay)
+			select {
+			case <-t.C:
+			case <-k.page.ctx.Done():
+				t.Stop()
+				return k.page.ctx.Err()
+			}
+		}
+
+		key := input.Key(r)
+		if r == '\n' {
+			key = input.Enter
+		}
+
+		var err error
+		switch {
+		case !key.Defined():
+			err = k.page.InsertText(string(r))
+		case key.Shifted():
+			err = k.page.KeyActions().Press(input.ShiftLeft).Type(key).Do()
+		default:
+			err = k.Type(key)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // KeyActionType enum.
 type KeyActionType int
 
@@ -156,25 +220,31 @@ func (ka *KeyActions) Do() (err error) {
 	return
 }
 
-// Make sure there's at least one release after the presses, such as:
+// Make sure there's at least one release after the presses, the keys are released in reverse order
+// of their last presses, such as:
 //
 //	p1,p2,p1,r1 => p1,p2,p1,r1,r2
+//	p1,p2 => p1,p2,r2,r1
 func (ka *KeyActions) balance() []KeyAction {
 	actions := ka.Actions
 
 	h := map[input.Key]bool{}
+	order := []input.Key{}
 	for _, a := range actions {
 		switch a.Type {
 		case KeyActionPress:
 			h[a.Key] = true
+			order = append(order, a.Key)
 		case KeyActionRelease, KeyActionTypeKey:
 			h[a.Key] = false
 		}
 	}
 
-	for key, needRelease := range h {
-		if needRelease {
+	for i := len(order) - 1; i >= 0; i-- {
+		key := order[i]
+		if h[key] {
 			actions = append(actions, KeyAction{KeyActionRelease, key})
+			h[key] = false
 		}
 	}
 
@@ -321,7 +391,15 @@ func (m *Mouse) Scroll(offsetX, offsetY float64, steps int) error {
 	return nil
 }
 
-// Down holds the button down.
+// Pressed returns the buttons that are currently held down, in the order they were pressed.
+func (m *Mouse) Pressed() []proto.InputMouseButton {
+	m.Lock()
+	defer m.Unlock()
+	return append([]proto.InputMouseButton{}, m.buttons...)
+}
+
+// Down holds the button down. The button stays pressed until [Mouse.Up] is called,
+// the later mouse moves will carry the held buttons, such as to drag a slider or draw on a canvas.
 func (m *Mouse) Down(button proto.InputMouseButton, clickCount int) error {
 	m.Lock()
 	defer m.Unlock()
@@ -455,3 +533,37 @@ func (t *Touch) Tap(x, y float64) error {
 
 	return t.End()
 }
+
+// Swipe dispatches a touchstart at from, then the touchmove events along the line to the destination
+// with the given steps, then a touchend.
+func (t *Touch) Swipe(from, to proto.Point, steps int) error {
+	defer t.page.tryTrace(TraceTypeInput, "swipe")()
+	t.page.browser.trySlowMotion()
+
+	if steps < 1 {
+		steps = 1
+	}
+
+	p := &proto.InputTouchPoint{X: from.X, Y: from.Y}
+
+	err := t.Start(p)
+	if err != nil {
+		return err
+	}
+
+	step := to.Minus(from).Scale(1 / float64(steps))
+	for i := 1; i <= steps; i++ {
+		pt := from.Add(step.Scale(float64(i)))
+		if i == steps {
+			pt = to
+		}
+		p.MoveTo(pt.X, pt.Y)
+
+		err = t.Move(p)
+		if err != nil {
+			return err
+		}
+	}
+
+	return t.End()
+}
@@ -2,6 +2,7 @@ package rod_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/yontaruron/rod/lib/devices"
 	"github.com/yontaruron/rod/lib/input"
@@ -53,6 +54,69 @@ func TestKeyType(t *testing.T) {
 	g.Eq("1 A b test", el.MustText())
 }
 
+func TestKeyCombo(t *testing.T) {
+	g := setup(t)
+
+	p := g.page.MustNavigate(g.srcFile("fixtures/keys.html"))
+	body := p.MustElement("body")
+
+	p.Keyboard.MustCombo(input.ControlLeft, input.ShiftLeft, 'k')
+	g.Eq(body.MustText(), `↓ "Control" ControlLeft 17 modifiers(ctrl)
+↓ "Shift" ShiftLeft 16 modifiers(ctrl,shift)
+↓ "k" KeyK 75 modifiers(ctrl,shift)
+↑ "k" KeyK 75 modifiers(ctrl,shift)
+↑ "Shift" ShiftLeft 16 modifiers(ctrl)
+↑ "Control" ControlLeft 17 modifiers()
+`)
+
+	body.MustEval("() => this.innerText = ''")
+	p.KeyActions().Press(input.ControlLeft, input.AltLeft).Type('a').MustDo()
+	g.Eq(body.MustText(), `↓ "Control" ControlLeft 17 modifiers(ctrl)
+↓ "Alt" AltLeft 18 modifiers(ctrl,alt)
+↓ "a" KeyA 65 modifiers(ctrl,alt)
+↑ "a" KeyA 65 modifiers(ctrl,alt)
+↑ "Alt" AltLeft 18 modifiers(ctrl)
+↑ "Control" ControlLeft 17 modifiers()
+`)
+
+	g.mc.stubErr(2, proto.InputDispatchKeyEvent{})
+	g.Err(p.Keyboard.Combo(input.ControlLeft, 'a'))
+
+	g.mc.stubErr(3, proto.InputDispatchKeyEvent{})
+	g.Err(p.Keyboard.Combo(input.ControlLeft, 'a'))
+}
+
+func TestKeyTypeText(t *testing.T) {
+	g := setup(t)
+
+	p := g.page.MustNavigate(g.srcFile("fixtures/input.html"))
+	el := p.MustElement("[type=text]")
+	el.MustEval(`() => {
+		this.dataset['keys'] = ''
+		this.onkeydown = e => this.dataset['keys'] += e.key + (e.shiftKey ? '+shift' : '') + ','
+	}`)
+
+	start := time.Now()
+	el.MustTypeText("aB!é", 100*time.Millisecond)
+	g.Gte(time.Since(start), 300*time.Millisecond)
+
+	g.Eq("aB!é", el.MustText())
+	g.Eq(el.MustEval(`() => this.dataset['keys']`).Str(), "a,Shift+shift,B+shift,Shift+shift,!+shift,")
+
+	ctx := g.Context()
+	ctx.Cancel()
+	g.Err(p.Context(ctx).Keyboard.TypeText("ab", time.Minute))
+
+	g.mc.stubErr(1, proto.RuntimeCallFunctionOn{})
+	g.Err(el.TypeText("a", 0))
+
+	g.mc.stubErr(1, proto.InputDispatchKeyEvent{})
+	g.Err(p.Keyboard.TypeText("a", 0))
+
+	g.mc.stubErr(1, proto.InputInsertText{})
+	g.Err(p.Keyboard.TypeText("é", 0))
+}
+
 func TestKeyTypeErr(t *testing.T) {
 	g := setup(t)
 
@@ -177,8 +241,19 @@ func TestMouseDoubleClick(t *testing.T) {
 
 	page := g.page.MustNavigate(g.srcFile("fixtures/double-click.html"))
 	el := page.MustElement("button")
+	el.MustEval(`() => {
+		window.clickCount = 0
+		this.onclick = () => window.clickCount++
+	}`)
 	el.MustDoubleClick()
 	g.Eq(el.MustText(), "ok")
+	g.Eq(page.MustEval(`() => window.clickCount`).Int(), 2)
+
+	g.mc.stubErr(1, proto.RuntimeCallFunctionOn{})
+	g.Err(el.DoubleClick(proto.InputMouseButtonLeft))
+
+	g.mc.stubErr(1, proto.InputDispatchMouseEvent{})
+	g.Err(el.DoubleClick(proto.InputMouseButtonLeft))
 }
 
 func TestMouseDrag(t *testing.T) {
@@ -196,6 +271,34 @@ func TestMouseDrag(t *testing.T) {
 	g.Eq(page.MustEval(`() => dragTrack`).Str(), " move 3 3 down 3 3 move 22 28 move 41 54 move 60 80 up 60 80")
 }
 
+func TestMousePressed(t *testing.T) {
+	g := setup(t)
+
+	p := g.newPage(g.blank()).MustWaitLoad()
+	p.MustEval(`() => {
+		window.moveButtons = []
+		document.onmousemove = e => window.moveButtons.push(e.buttons)
+	}`)
+	mouse := p.Mouse
+
+	mouse.MustMoveTo(10, 10)
+	mouse.MustDown(proto.InputMouseButtonLeft)
+	mouse.MustDown(proto.InputMouseButtonRight)
+	g.Eq(mouse.Pressed(), []proto.InputMouseButton{proto.InputMouseButtonLeft, proto.InputMouseButtonRight})
+
+	mouse.MustMoveTo(20, 20)
+	mouse.MustUp(proto.InputMouseButtonRight)
+	g.Eq(mouse.Pressed(), []proto.InputMouseButton{proto.InputMouseButtonLeft})
+
+	mouse.MustMoveTo(30, 30)
+	mouse.MustUp(proto.InputMouseButtonLeft)
+	g.Len(mouse.Pressed(), 0)
+
+	mouse.MustMoveTo(40, 40)
+
+	g.Eq(p.MustEval(`() => moveButtons.join(',')`).Str(), "0,3,1,0")
+}
+
 func TestMouseScroll(t *testing.T) {
 	g := setup(t)
 
@@ -254,6 +357,39 @@ func TestNativeDrag(t *testing.T) { // devtools doesn't support to use mouse eve
 	page.MustElement(".dropzone:nth-child(2) #draggable")
 }
 
+func TestTouchSwipe(t *testing.T) {
+	g := setup(t)
+
+	page := g.newPage().MustEmulateTouch(true)
+	page.MustNavigate(g.srcFile("fixtures/touch.html")).MustWaitLoad()
+
+	g.Eq(page.MustEval(`() => navigator.maxTouchPoints`).Int(), 5)
+
+	page.Touch.MustSwipe(proto.NewPoint(10, 10), proto.NewPoint(40, 70), 3)
+
+	page.MustWait(`() => touchTrack == ' start 10 10 move 20 30 move 30 50 move 40 70 end'`)
+
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.InputDispatchTouchEvent{})
+		page.Touch.MustSwipe(proto.NewPoint(10, 10), proto.NewPoint(40, 70), 0)
+	})
+	g.Panic(func() {
+		g.mc.stubErr(2, proto.InputDispatchTouchEvent{})
+		page.Touch.MustSwipe(proto.NewPoint(10, 10), proto.NewPoint(40, 70), 0)
+	})
+
+	page.MustEmulateTouch(false)
+
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.EmulationSetTouchEmulationEnabled{})
+		page.MustEmulateTouch(true)
+	})
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.EmulationSetDeviceMetricsOverride{})
+		page.MustEmulateTouch(true)
+	})
+}
+
 func TestTouch(t *testing.T) {
 	g := setup(t)
 
@@ -0,0 +1,39 @@
+// Example run:
+// go test -bench HTML ./lib/benchmark
+//
+// It compares DOM.getOuterHTML, which Page.HTML uses, with evaluating the outerHTML by value.
+// DOM.getOuterHTML serializes the DOM in the browser process and sends the string once,
+// while the eval creates a js string in the page first and then copies it out through the js runtime.
+// Record the result of a run here when the way Page.HTML gets the HTML is reconsidered.
+
+package main_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/yontaruron/rod"
+	"github.com/ysmood/got"
+)
+
+// Compare the ways to get the HTML of a page with tens of thousands of nodes.
+func BenchmarkHTML(b *testing.B) {
+	u := got.New(b).Serve().Route("/", "", strings.Repeat("<div><p>item</p></div>", 20000)).URL("/")
+
+	browser := rod.New().MustConnect()
+	b.Cleanup(browser.MustClose)
+
+	page := browser.MustPage(u).MustWaitLoad()
+
+	b.Run("DOM.getOuterHTML", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			page.MustHTML()
+		}
+	})
+
+	b.Run("eval by value", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			page.MustEval(`() => document.documentElement.outerHTML`).Str()
+		}
+	})
+}
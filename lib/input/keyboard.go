@@ -70,6 +70,24 @@ type KeyInfo struct {
 	Location int    // 1
 }
 
+// Defined returns true if the key is in the key map, such as 'a', 'A', and [Enter].
+func (k Key) Defined() bool {
+	if _, has := keyMap[k]; has {
+		return true
+	}
+	_, has := keyMapShifted[k]
+	return has
+}
+
+// Shifted returns true if the key can only be typed with the shift key held, such as 'A' and '!'.
+func (k Key) Shifted() bool {
+	if _, has := keyMap[k]; has {
+		return false
+	}
+	_, has := keyMapShifted[k]
+	return has
+}
+
 // Shift returns the shifted key, such as shifted "1" is "!".
 func (k Key) Shift() (Key, bool) {
 	s, has := keyShiftedMap[k]
@@ -147,3 +147,18 @@ func TestMac(t *testing.T) {
 		},
 	})
 }
+
+func TestKeyDefined(t *testing.T) {
+	g := got.T(t)
+
+	g.True(input.Key('a').Defined())
+	g.True(input.Key('A').Defined())
+	g.True(input.Enter.Defined())
+	g.False(input.Key('é').Defined())
+
+	g.False(input.Key('a').Shifted())
+	g.True(input.Key('A').Shifted())
+	g.True(input.Key('!').Shifted())
+	g.False(input.Enter.Shifted())
+	g.False(input.Key('é').Shifted())
+}
@@ -43,6 +43,13 @@ var ElementR = &Function{
 	Dependencies: []*Function{Selectable, Text},
 }
 
+// ElementVisible ...
+var ElementVisible = &Function{
+	Name:         "elementVisible",
+	Definition:   `function(e){return Array.from(functions.selectable(this).querySelectorAll(e)).find(e=>functions.visible.call(e))||null}`,
+	Dependencies: []*Function{Selectable, Visible},
+}
+
 // Parents ...
 var Parents = &Function{
 	Name:         "parents",
@@ -117,7 +124,7 @@ var WaitIdle = &Function{
 // WaitLoad ...
 var WaitLoad = &Function{
 	Name:         "waitLoad",
-	Definition:   `function(){const n=this===window;return new Promise((e,t)=>{if(n){if("complete"===document.readyState)return e();window.addEventListener("load",e)}else void 0===this.complete||this.complete?e():(this.addEventListener("load",e),this.addEventListener("error",t))})}`,
+	Definition:   `function(){const n=this===window;return new Promise((e,t)=>{if(n){if("complete"===document.readyState)return e();window.addEventListener("load",e)}else if(this instanceof HTMLMediaElement){if(this.readyState>=HTMLMediaElement.HAVE_CURRENT_DATA)return e();this.addEventListener("loadeddata",e),this.addEventListener("error",t)}else void 0===this.complete||this.complete?e():(this.addEventListener("load",e),this.addEventListener("error",t))})}`,
 	Dependencies: []*Function{},
 }
 
@@ -152,14 +159,14 @@ var SelectText = &Function{
 // SelectAllText ...
 var SelectAllText = &Function{
 	Name:         "selectAllText",
-	Definition:   `function(){this.select()}`,
+	Definition:   `function(){var e,t;this.select?this.select():((e=document.createRange()).selectNodeContents(this),(t=window.getSelection()).removeAllRanges(),t.addRange(e))}`,
 	Dependencies: []*Function{},
 }
 
 // Select ...
 var Select = &Function{
 	Name:         "select",
-	Definition:   `function(e,t,n){let r;switch(n){case"regex":r=e.map(e=>{const t=new RegExp(e);return e=>t.test(e.innerText)});break;case"css-selector":r=e.map(t=>e=>e.matches(t));break;default:r=e.map(t=>e=>e.innerText.includes(t))}const i=Array.from(this.options);let o=!1;return r.forEach(e=>{e=i.find(e);e&&(e.selected=t,o=!0)}),this.dispatchEvent(new Event("input",{bubbles:!0})),this.dispatchEvent(new Event("change",{bubbles:!0})),o}`,
+	Definition:   `function(e,t,n){let r;switch(n){case"regex":r=e.map(e=>{const t=new RegExp(e);return e=>t.test(e.innerText)});break;case"css-selector":r=e.map(t=>e=>e.matches(t));break;case"value":r=e.map(t=>e=>e.value===t);break;case"index":r=e.map(t=>e=>e.index===Number(t));break;default:r=e.map(t=>e=>e.innerText.includes(t))}const i=Array.from(this.options);let o=!1;return r.forEach(e=>{e=i.find(e);e&&(e.selected=t,o=!0)}),this.dispatchEvent(new Event("input",{bubbles:!0})),this.dispatchEvent(new Event("change",{bubbles:!0})),o}`,
 	Dependencies: []*Function{},
 }
 
@@ -222,7 +229,7 @@ var Tag = &Function{
 // ExposeFunc ...
 var ExposeFunc = &Function{
 	Name:         "exposeFunc",
-	Definition:   `function(e,t){let o=0;window[e]=e=>new Promise((n,r)=>{const i=t+"_cb"+o++;window[i]=(e,t)=>{delete window[i],t?r(t):n(e)},window[t](JSON.stringify({req:e,cb:i}))})}`,
+	Definition:   `function(e,t){let o=0;window[e]=e=>new Promise((n,r)=>{const i=t+"_cb"+o++;window[i]=(e,t)=>{delete window[i],null===t?n(e):r(t)},window[t](JSON.stringify({req:e,cb:i}))})}`,
 	Dependencies: []*Function{},
 }
 
@@ -232,3 +239,10 @@ var GetXPath = &Function{
 	Definition:   `function(e){class i{constructor(e,t){this.value=e,this.optimized=t||!1}toString(){return this.value}}function o(t){function n(e,t){return e===t||(e.nodeType===Node.ELEMENT_NODE&&t.nodeType===Node.ELEMENT_NODE?e.localName===t.localName:e.nodeType===t.nodeType||(e.nodeType===Node.CDATA_SECTION_NODE?Node.TEXT_NODE:e.nodeType)===(t.nodeType===Node.CDATA_SECTION_NODE?Node.TEXT_NODE:t.nodeType))}var e=t.parentNode,r=e?e.children:null;if(!r)return 0;let i;for(let e=0;e<r.length;++e)if(n(t,r[e])&&r[e]!==t){i=!0;break}if(!i)return 0;let o=1;for(let e=0;e<r.length;++e)if(n(t,r[e])){if(r[e]===t)return o;++o}return-1}if(this.nodeType===Node.DOCUMENT_NODE)return"/";var t=[];let n=this;for(;n;){var r=function(e,t){let n;var r=o(e);if(-1===r)return null;switch(e.nodeType){case Node.ELEMENT_NODE:if(t&&e.id)return new i(` + "`" + `//*[@id='${e.id}']` + "`" + `,!0);n=e.localName;break;case Node.ATTRIBUTE_NODE:n="@"+e.nodeName;break;case Node.TEXT_NODE:case Node.CDATA_SECTION_NODE:n="text()";break;case Node.PROCESSING_INSTRUCTION_NODE:n="processing-instruction()";break;case Node.COMMENT_NODE:n="comment()";break;default:Node.DOCUMENT_NODE;n=""}return 0<r&&(n+=` + "`" + `[${r}]` + "`" + `),new i(n,e.nodeType===Node.DOCUMENT_NODE)}(n,e);if(!r)break;if(t.push(r),r.optimized)break;n=n.parentNode}return t.reverse(),(t.length&&t[0].optimized?"":"/")+t.join("/")}`,
 	Dependencies: []*Function{},
 }
+
+// GetSelector ...
+var GetSelector = &Function{
+	Name:         "getSelector",
+	Definition:   `function(){var e=[];let t=functions.tag(this);for(;t;){if(t.id){e.unshift("#"+CSS.escape(t.id));break}let n=t.localName;var r=t.parentElement;r&&1<r.children.length&&(n+=":nth-child("+(Array.from(r.children).indexOf(t)+1)+")"),e.unshift(n),t=r}return e.join(" > ")}`,
+	Dependencies: []*Function{Tag},
+}
@@ -101,6 +101,14 @@ const functions = {
     return el ? el : null
   },
 
+  elementVisible(selector) {
+    const s = functions.selectable(this)
+    const el = Array.from(s.querySelectorAll(selector)).find((e) =>
+      functions.visible.call(e)
+    )
+    return el ? el : null
+  },
+
   parents(selector) {
     let p = this.parentElement
     const list = []
@@ -249,6 +257,10 @@ const functions = {
       if (isWin) {
         if (document.readyState === 'complete') return resolve()
         window.addEventListener('load', resolve)
+      } else if (this instanceof HTMLMediaElement) {
+        if (this.readyState >= HTMLMediaElement.HAVE_CURRENT_DATA) return resolve()
+        this.addEventListener('loadeddata', resolve)
+        this.addEventListener('error', reject)
       } else {
         if (this.complete === undefined || this.complete) {
           resolve()
@@ -307,7 +319,17 @@ const functions = {
   },
 
   selectAllText() {
-    this.select()
+    if (this.select) {
+      this.select()
+      return
+    }
+
+    // for contenteditable elements
+    const range = document.createRange()
+    range.selectNodeContents(this)
+    const selection = window.getSelection()
+    selection.removeAllRanges()
+    selection.addRange(range)
   },
 
   select(selectors, selected, type) {
@@ -322,6 +344,12 @@ const functions = {
       case 'css-selector':
         matchers = selectors.map((s) => (el) => el.matches(s))
         break
+      case 'value':
+        matchers = selectors.map((s) => (el) => el.value === s)
+        break
+      case 'index':
+        matchers = selectors.map((s) => (el) => el.index === Number(s))
+        break
       default:
         matchers = selectors.map((s) => (el) => el.innerText.includes(s))
         break
@@ -445,7 +473,7 @@ const functions = {
         const cb = bind + '_cb' + callbackCount++
         window[cb] = (res, err) => {
           delete window[cb]
-          err ? reject(err) : resolve(res)
+          err === null ? resolve(res) : reject(err)
         }
         window[bind](JSON.stringify({ req, cb }))
       })
@@ -568,5 +596,24 @@ const functions = {
     }
     steps.reverse()
     return (steps.length && steps[0].optimized ? '' : '/') + steps.join('/')
+  },
+
+  getSelector() {
+    const path = []
+    let el = functions.tag(this)
+    while (el) {
+      if (el.id) {
+        path.unshift('#' + CSS.escape(el.id))
+        break
+      }
+      let sel = el.localName
+      const parent = el.parentElement
+      if (parent && parent.children.length > 1) {
+        sel += ':nth-child(' + (Array.from(parent.children).indexOf(el) + 1) + ')'
+      }
+      path.unshift(sel)
+      el = parent
+    }
+    return path.join(' > ')
   }
 }
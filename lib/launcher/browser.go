@@ -80,9 +80,15 @@ type Browser struct {
 	// Such as [HostGoogle] or [HostNPM].
 	Hosts []Host
 
-	// Revision of the browser to use
+	// Revision of the browser to use, pin it for reproducible environments.
+	// Each revision is downloaded to its own [Browser.Dir].
 	Revision int
 
+	// ExpectedVersion is the browser version the Revision is expected to have, such as "120.0.6099.0".
+	// If it's not empty, [Browser.Validate] will check it against the output of [Browser.Version],
+	// so that a corrupted or swapped executable will be re-downloaded by [Browser.Get].
+	ExpectedVersion string
+
 	// RootDir to download different browser versions.
 	RootDir string
 
@@ -94,6 +100,14 @@ type Browser struct {
 
 	// HTTPClient to download the browser
 	HTTPClient *http.Client
+
+	// Checksum is the expected sha256 hex of the downloaded archive.
+	// If it's not empty, an archive that doesn't match it will be discarded and the next host will be tried.
+	Checksum string
+
+	// Progress is called periodically while downloading the browser archive,
+	// the total is -1 if the host doesn't report the size. Use [Browser.Context] to abort the download.
+	Progress func(downloaded, total int64)
 }
 
 // NewBrowser with default values.
@@ -126,6 +140,8 @@ func (lc *Browser) BinPath() string {
 
 // Download browser from the fastest host.
 // It will race downloading a TCP packet from each host and use the fastest host.
+// If the download from the fastest host fails, the rest of the [Browser.Hosts] will be tried in order.
+// The partial download will be removed if all of them fail.
 func (lc *Browser) Download() error {
 	us := []string{}
 	for _, host := range lc.Hosts {
@@ -140,13 +156,49 @@ func (lc *Browser) Download() error {
 	if lc.HTTPClient != nil {
 		fu.HttpClient = lc.HTTPClient
 	}
+	if lc.Checksum != "" {
+		c := *fu.HttpClient
+		c.Transport = &checksumTransport{c.Transport, lc.Checksum}
+		fu.HttpClient = &c
+	}
 
-	err := fu.Fetch()
-	if err != nil {
-		return fmt.Errorf("can't find a browser binary for your OS, the doc might help https://go-rod.github.io/#/compatibility?id=os : %w", err) //nolint: lll
+	fastest := fu.FastestURL()
+	if err := lc.Context.Err(); err != nil {
+		return err
+	}
+	if fastest == "" {
+		return fmt.Errorf("can't find a browser binary for your OS, the doc might help https://go-rod.github.io/#/compatibility?id=os : %w", &fetchup.ErrNoURLs{URLs: us}) //nolint: lll
+	}
+
+	if lc.Progress != nil {
+		c := *fu.HttpClient
+		c.Transport = &progressTransport{c.Transport, fu.MinReportSpan, lc.Progress}
+		fu.HttpClient = &c
+	}
+
+	candidates := []string{fastest}
+	for _, u := range us {
+		if u != fastest {
+			candidates = append(candidates, u)
+		}
+	}
+
+	var err error
+	for _, u := range candidates {
+		err = fu.Download(u)
+		if err == nil {
+			return fetchup.StripFirstDir(dir)
+		}
+
+		lc.Logger.Println("failed to download", u, err)
+		_ = os.RemoveAll(dir)
+
+		if lc.Context.Err() != nil {
+			break
+		}
 	}
 
-	return fetchup.StripFirstDir(dir)
+	return fmt.Errorf("failed to download the browser: %w", err)
 }
 
 // Get is a smart helper to get the browser executable path.
@@ -159,7 +211,12 @@ func (lc *Browser) Get() (string, error) {
 	// Try to cleanup before downloading
 	_ = os.RemoveAll(lc.Dir())
 
-	return lc.BinPath(), lc.Download()
+	err := lc.Download()
+	if err != nil {
+		return lc.BinPath(), err
+	}
+
+	return lc.BinPath(), lc.validateVersion()
 }
 
 // MustGet is similar with Get.
@@ -169,14 +226,30 @@ func (lc *Browser) MustGet() string {
 	return p
 }
 
+// Version returns the version of the browser executable at [Browser.BinPath],
+// such as "Chromium 120.0.6099.0". Call it after [Browser.Get] to log the resolved version.
+func (lc *Browser) Version() (string, error) {
+	b, err := exec.Command(lc.BinPath(), "--version").CombinedOutput()
+	if err != nil {
+		return "", fmt.Errorf("failed to get the browser version: %w\n%s", err, b)
+	}
+	return strings.TrimSpace(string(b)), nil
+}
+
 // Validate returns nil if the browser executable is valid.
-// If the executable is malformed it will return error.
+// If the executable is malformed, or its version doesn't match [Browser.ExpectedVersion], it will return error.
+// [Browser.Get] uses it to decide whether to re-download a corrupted browser.
 func (lc *Browser) Validate() error {
 	_, err := os.Stat(lc.BinPath())
 	if err != nil {
 		return err
 	}
 
+	err = lc.validateVersion()
+	if err != nil {
+		return err
+	}
+
 	cmd := exec.Command(lc.BinPath(), "--headless", "--no-sandbox",
 		"--use-mock-keychain", "--disable-dev-shm-usage",
 		"--disable-gpu", "--dump-dom", "about:blank")
@@ -196,6 +269,21 @@ func (lc *Browser) Validate() error {
 	return nil
 }
 
+func (lc *Browser) validateVersion() error {
+	if lc.ExpectedVersion == "" {
+		return nil
+	}
+
+	v, err := lc.Version()
+	if err != nil {
+		return err
+	}
+	if !strings.Contains(v, lc.ExpectedVersion) {
+		return &ErrVersion{Expected: lc.ExpectedVersion, Actual: v}
+	}
+	return nil
+}
+
 // LookPath searches for the browser executable from often used paths on current operating system.
 func LookPath() (found string, has bool) {
 	list := map[string][]string{
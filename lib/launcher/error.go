@@ -1,6 +1,35 @@
 package launcher
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 // ErrAlreadyLaunched is an error that indicates the launcher has already been launched.
 var ErrAlreadyLaunched = errors.New("already launched")
+
+// ErrChecksum is returned when the downloaded archive doesn't match [Browser.Checksum].
+type ErrChecksum struct {
+	Expected string
+	Actual   string
+}
+
+func (e *ErrChecksum) Error() string {
+	return fmt.Sprintf("checksum mismatch, expected sha256 %s, got %s", e.Expected, e.Actual)
+}
+
+// Is interface.
+func (e *ErrChecksum) Is(err error) bool { _, ok := err.(*ErrChecksum); return ok }
+
+// ErrVersion is returned when the browser executable doesn't match [Browser.ExpectedVersion].
+type ErrVersion struct {
+	Expected string
+	Actual   string
+}
+
+func (e *ErrVersion) Error() string {
+	return fmt.Sprintf("browser version mismatch, expected %s, got %s", e.Expected, e.Actual)
+}
+
+// Is interface.
+func (e *ErrVersion) Is(err error) bool { _, ok := err.(*ErrVersion); return ok }
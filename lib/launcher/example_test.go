@@ -36,7 +36,17 @@ func Example_custom_launch() {
 	parser := launcher.NewURLParser()
 	cmd.Stderr = parser
 	utils.E(cmd.Start())
-	u := launcher.MustResolveURL(<-parser.URL)
+
+	// close the exit when the browser exits, so the WaitURL won't hang if the browser fails to start
+	exit := make(chan struct{})
+	go func() {
+		_ = cmd.Wait()
+		close(exit)
+	}()
+
+	u, err := parser.WaitURL(exit)
+	utils.E(err)
+	u = launcher.MustResolveURL(u)
 
 	rod.New().ControlURL(u).MustConnect()
 }
@@ -0,0 +1,17 @@
+// Package main ...
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+func main() {
+	for _, arg := range os.Args[1:] {
+		if arg == "--version" {
+			fmt.Println("Chromium 120.0.6099.0 ")
+			return
+		}
+	}
+	fmt.Println("<html><head></head><body></body></html>")
+}
@@ -48,6 +48,12 @@ const (
 	// Preferences flag.
 	Preferences Flag = "rod-preferences"
 
+	// LoadExtension flag.
+	LoadExtension Flag = "load-extension"
+
+	// DisableExtensionsExcept flag.
+	DisableExtensionsExcept Flag = "disable-extensions-except"
+
 	// Leakless flag.
 	Leakless Flag = "rod-leakless" // @TODO remove - redundant flag
 
@@ -35,6 +35,9 @@ type Launcher struct {
 	pid     int
 	exit    chan struct{}
 
+	// the user-data-dir generated by New, it will be removed after the browser exits
+	tmpUserDataDir string
+
 	managed    bool
 	serviceURL string
 
@@ -44,7 +47,8 @@ type Launcher struct {
 // New returns the default arguments to start browser.
 // Headless will be enabled by default.
 // Leakless will be enabled by default.
-// UserDataDir will use OS tmp dir by default, this folder will usually be cleaned up by the OS after reboot.
+// UserDataDir will use OS tmp dir by default, it will be removed after the browser exits,
+// use [Launcher.UserDataDir] to set a dir that you want to keep.
 // It will auto download the browser binary according to the current platform,
 // check [Launcher.Bin] and [Launcher.Revision] for more info.
 func New() *Launcher {
@@ -107,15 +111,21 @@ func New() *Launcher {
 		defaultFlags[flags.ProxyServer] = []string{defaults.Proxy}
 	}
 
+	tmpUserDataDir := ""
+	if defaults.Dir == "" {
+		tmpUserDataDir = dir
+	}
+
 	ctx, cancel := context.WithCancel(context.Background())
 	return &Launcher{
-		ctx:       ctx,
-		ctxCancel: cancel,
-		Flags:     defaultFlags,
-		exit:      make(chan struct{}),
-		browser:   NewBrowser(),
-		parser:    NewURLParser(),
-		logger:    io.Discard,
+		ctx:            ctx,
+		ctxCancel:      cancel,
+		Flags:          defaultFlags,
+		exit:           make(chan struct{}),
+		browser:        NewBrowser(),
+		parser:         NewURLParser(),
+		logger:         io.Discard,
+		tmpUserDataDir: tmpUserDataDir,
 	}
 }
 
@@ -213,13 +223,17 @@ func (l *Launcher) Bin(path string) *Launcher {
 	return l.Set(flags.Bin, path)
 }
 
-// Revision of the browser to auto download.
+// Revision of the browser to auto download. Pin it to get the same browser on every machine,
+// use [Browser.Version] to check the version of the downloaded browser.
 func (l *Launcher) Revision(rev int) *Launcher {
 	l.browser.Revision = rev
 	return l
 }
 
 // Headless switch. Whether to run browser in headless mode. A mode without visible UI.
+// It's the old headless mode, a lightweight implementation that starts fast, but it doesn't
+// support some browser features, such as extensions. Use [Launcher.HeadlessNew] for them.
+// It overrides the previous [Launcher.HeadlessNew] call, because they share the same flag.
 func (l *Launcher) Headless(enable bool) *Launcher {
 	if enable {
 		return l.Set(flags.Headless)
@@ -228,6 +242,9 @@ func (l *Launcher) Headless(enable bool) *Launcher {
 }
 
 // HeadlessNew switch is the "--headless=new" switch: https://developer.chrome.com/docs/chromium/new-headless
+// The new headless mode runs the same browser as the headful one, so it supports extensions and behaves closer
+// to a real browser, but it's heavier than the old one.
+// It overrides the previous [Launcher.Headless] call, because they share the same flag.
 func (l *Launcher) HeadlessNew(enable bool) *Launcher {
 	if enable {
 		return l.Set(flags.Headless, "new")
@@ -282,6 +299,28 @@ func (l *Launcher) Devtools(autoOpenForTabs bool) *Launcher {
 	return l.Delete("auto-open-devtools-for-tabs")
 }
 
+// Extension loads the unpacked extensions from the dirs, each dir should contain a manifest.json,
+// the other extensions will be disabled. Because the old headless mode doesn't support extensions,
+// the headless mode will be switched to [Launcher.HeadlessNew] if it's enabled.
+func (l *Launcher) Extension(dirs ...string) error {
+	dirs = utils.AbsolutePaths(dirs)
+
+	for _, dir := range dirs {
+		if !utils.FileExists(filepath.Join(dir, "manifest.json")) {
+			return fmt.Errorf("can't find the manifest.json of the extension: %s", dir)
+		}
+	}
+
+	l.Append(flags.LoadExtension, dirs...)
+	l.Append(flags.DisableExtensionsExcept, dirs...)
+
+	if l.Has(flags.Headless) {
+		l.HeadlessNew(true)
+	}
+
+	return nil
+}
+
 // IgnoreCerts configure the Chrome's ignore-certificate-errors-spki-list argument with the public keys.
 func (l *Launcher) IgnoreCerts(pks []crypto.PublicKey) error {
 	spkis := make([]string, 0, len(pks))
@@ -301,6 +340,9 @@ func (l *Launcher) IgnoreCerts(pks []crypto.PublicKey) error {
 
 // UserDataDir is where the browser will look for all of its state, such as cookie and cache.
 // When set to empty, browser will use current OS home dir.
+// Unlike the default tmp dir, the dir set here won't be removed after the browser exits.
+// Point it to an existing browser profile to reuse its login sessions across runs, but the browser locks
+// the dir while running, so it can't be shared with another running browser instance at the same time.
 // Related doc: https://chromium.googlesource.com/chromium/src/+/master/docs/user_data_dir.md
 func (l *Launcher) UserDataDir(dir string) *Launcher {
 	if dir == "" {
@@ -311,7 +353,8 @@ func (l *Launcher) UserDataDir(dir string) *Launcher {
 	return l
 }
 
-// ProfileDir is the browser profile the browser will use.
+// ProfileDir is the browser profile the browser will use, it's the "--profile-directory" flag,
+// which is a sub dir name of the [Launcher.UserDataDir], such as "Profile 1".
 // When set to empty, the profile 'Default' is used.
 // Related article: https://superuser.com/a/377195
 func (l *Launcher) ProfileDir(dir string) *Launcher {
@@ -330,7 +373,8 @@ func (l *Launcher) RemoteDebuggingPort(port int) *Launcher {
 	return l.Set(flags.RemoteDebuggingPort, fmt.Sprintf("%d", port))
 }
 
-// Proxy for the browser.
+// Proxy for the browser, such as "127.0.0.1:8080" or "socks5://127.0.0.1:1080".
+// If the proxy requires authentication, use rod.Browser.HandleAuth to provide the credentials.
 func (l *Launcher) Proxy(host string) *Launcher {
 	return l.Set(flags.ProxyServer, host)
 }
@@ -451,6 +495,7 @@ func (l *Launcher) Launch() (string, error) {
 
 	go func() {
 		_ = cmd.Wait()
+		l.removeTmpUserDataDir()
 		close(l.exit)
 	}()
 
@@ -525,6 +570,11 @@ func (l *Launcher) PID() int {
 	return l.pid
 }
 
+// Exited returns a channel that will be closed when the launched browser process exits.
+func (l *Launcher) Exited() <-chan struct{} {
+	return l.exit
+}
+
 // Kill the browser process.
 func (l *Launcher) Kill() {
 	// TODO: If kill too fast, the browser's children processes may not be ready.
@@ -543,9 +593,18 @@ func (l *Launcher) Kill() {
 }
 
 // Cleanup wait until the Browser exits and remove [flags.UserDataDir].
+// Unlike the auto removal of the default tmp dir, it removes the dir even if it's set by [Launcher.UserDataDir].
 func (l *Launcher) Cleanup() {
 	<-l.exit
 
 	dir := l.Get(flags.UserDataDir)
 	_ = os.RemoveAll(dir)
 }
+
+// removeTmpUserDataDir removes the user-data-dir generated by New if it's still in use and not kept.
+func (l *Launcher) removeTmpUserDataDir() {
+	if l.tmpUserDataDir == "" || l.Has(flags.KeepUserDataDir) || l.Get(flags.UserDataDir) != l.tmpUserDataDir {
+		return
+	}
+	_ = os.RemoveAll(l.tmpUserDataDir)
+}
@@ -3,8 +3,11 @@ package launcher_test
 import (
 	"archive/zip"
 	"bytes"
+	"context"
 	"crypto"
+	"crypto/sha256"
 	"crypto/x509"
+	"encoding/hex"
 	"encoding/pem"
 	"flag"
 	"io"
@@ -13,6 +16,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"testing"
 
@@ -59,6 +63,83 @@ func TestDownload(t *testing.T) {
 	g.PathExists(b.Dir())
 }
 
+func TestDownloadProgress(t *testing.T) {
+	g := got.T(t)
+
+	buf := bytes.NewBuffer(nil)
+	z := zip.NewWriter(buf)
+	f, _ := z.Create(filepath.FromSlash("a/b/c.txt"))
+	_, _ = f.Write([]byte(g.RandStr(500 * 1024)))
+	_ = z.Close()
+
+	s := g.Serve()
+	s.Mux.HandleFunc("/a.zip", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Length", strconv.Itoa(buf.Len()))
+		_, _ = w.Write(buf.Bytes())
+	})
+
+	b := launcher.NewBrowser()
+	b.Revision = 4
+	b.Logger = utils.LoggerQuiet
+	b.Hosts = []launcher.Host{func(_ int) string {
+		return s.URL("/a.zip")
+	}}
+
+	var downloaded, total int64
+	b.Progress = func(d, t int64) {
+		downloaded, total = d, t
+	}
+
+	g.Cleanup(func() { _ = os.RemoveAll(b.Dir()) })
+
+	g.E(b.Download())
+	g.Eq(downloaded, int64(buf.Len()))
+	g.Eq(total, int64(buf.Len()))
+
+	ctx, cancel := context.WithCancel(g.Context())
+	cancel()
+	b.Context = ctx
+	g.Is(b.Download(), context.Canceled)
+}
+
+func TestDownloadChecksum(t *testing.T) {
+	g := got.T(t)
+
+	buf := bytes.NewBuffer(nil)
+	z := zip.NewWriter(buf)
+	f, _ := z.Create(filepath.FromSlash("a/b/c.txt"))
+	_, _ = f.Write([]byte(g.RandStr(500 * 1024)))
+	_ = z.Close()
+
+	sum := sha256.Sum256(buf.Bytes())
+
+	s := g.Serve()
+	corrupted := []byte(g.RandStr(500 * 1024))
+	s.Mux.HandleFunc("/a.zip", func(w http.ResponseWriter, _ *http.Request) { _, _ = w.Write(buf.Bytes()) })
+	s.Mux.HandleFunc("/b.zip", func(w http.ResponseWriter, _ *http.Request) { _, _ = w.Write(corrupted) })
+
+	b := launcher.NewBrowser()
+	b.Revision = 2
+	b.Logger = utils.LoggerQuiet
+	b.Checksum = hex.EncodeToString(sum[:])
+	b.Hosts = []launcher.Host{func(_ int) string {
+		return s.URL("/b.zip")
+	}}
+
+	g.Cleanup(func() { _ = os.RemoveAll(b.Dir()) })
+
+	err := b.Download()
+	g.Is(err, &launcher.ErrChecksum{})
+	_, err = os.Stat(b.Dir())
+	g.True(os.IsNotExist(err))
+
+	b.Hosts = append(b.Hosts, func(_ int) string {
+		return s.URL("/a.zip")
+	})
+	g.E(b.Download())
+	g.PathExists(filepath.Join(b.Dir(), "b", "c.txt"))
+}
+
 func TestLaunch(t *testing.T) {
 	g := setup(t)
 
@@ -145,6 +226,25 @@ func TestLaunchUserMode(t *testing.T) {
 	g.Eq(url, launcher.NewUserMode().RemoteDebuggingPort(port).MustLaunch())
 }
 
+func TestLaunchRemoveTmpUserDataDir(t *testing.T) {
+	g := setup(t)
+
+	l := launcher.New()
+	dir := l.Get(flags.UserDataDir)
+	l.MustLaunch()
+	g.True(utils.FileExists(dir))
+	l.Kill()
+	<-l.Exited()
+	g.False(utils.FileExists(dir))
+
+	dir = filepath.Join(t.TempDir(), "user-data")
+	l = launcher.New().UserDataDir(dir)
+	l.MustLaunch()
+	l.Kill()
+	<-l.Exited()
+	g.True(utils.FileExists(dir))
+}
+
 func TestUserModeErr(t *testing.T) {
 	g := setup(t)
 
@@ -189,6 +289,47 @@ func TestLaunchErr(t *testing.T) {
 	}
 }
 
+func TestUserDataDirAndProfileDir(t *testing.T) {
+	g := setup(t)
+
+	dir := filepath.Join(t.TempDir(), "user-data")
+	args := launcher.New().UserDataDir(dir).ProfileDir("Profile 1").FormatArgs()
+
+	g.Has(args, "--user-data-dir="+dir)
+	g.Has(args, "--profile-directory=Profile 1")
+}
+
+func TestHeadlessModes(t *testing.T) {
+	g := setup(t)
+
+	l := launcher.New()
+
+	g.Has(l.HeadlessNew(true).FormatArgs(), "--headless=new")
+	g.Has(l.Headless(true).FormatArgs(), "--headless")
+	v, _ := l.GetFlags(flags.Headless)
+	g.Len(v, 0)
+
+	l.HeadlessNew(false)
+	g.False(l.Has(flags.Headless))
+}
+
+func TestExtension(t *testing.T) {
+	g := setup(t)
+
+	dir := t.TempDir()
+	g.E(utils.OutputFile(filepath.Join(dir, "manifest.json"), `{"manifest_version": 3, "name": "test", "version": "1"}`))
+
+	l := launcher.New()
+	g.E(l.Extension(dir))
+
+	args := l.FormatArgs()
+	g.Has(args, "--load-extension="+dir)
+	g.Has(args, "--disable-extensions-except="+dir)
+	g.Has(args, "--headless=new")
+
+	g.Err(l.Extension(t.TempDir()))
+}
+
 var testProfileDir = flag.Bool("test-profile-dir", false, "set it to test profile dir")
 
 func TestProfileDir(t *testing.T) {
@@ -230,6 +371,43 @@ func TestBrowserValid(t *testing.T) {
 	g.Nil(b.Validate())
 }
 
+func TestBrowserVersion(t *testing.T) {
+	g := setup(t)
+
+	b := launcher.NewBrowser()
+	b.Revision = 3
+	_, err := b.Version()
+	g.Err(err)
+
+	g.E(utils.Mkdir(filepath.Dir(b.BinPath())))
+	g.Cleanup(func() { _ = os.RemoveAll(b.Dir()) })
+
+	g.E(exec.Command("go", "build", "-o", b.BinPath(), "./fixtures/chrome-version").CombinedOutput())
+	g.Nil(b.Validate())
+	g.Eq(b.MustGet(), b.BinPath())
+
+	v, err := b.Version()
+	g.E(err)
+	g.Eq(v, "Chromium 120.0.6099.0")
+
+	b.ExpectedVersion = "120.0.6099.0"
+	g.Nil(b.Validate())
+
+	// the executable doesn't match the pinned version
+	b.ExpectedVersion = "121.0.6167.0"
+	g.Is(b.Validate(), &launcher.ErrVersion{})
+
+	// Get removes the mismatched executable and re-downloads it
+	s := g.Serve()
+	s.Mux.HandleFunc("/", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	b.Logger = utils.LoggerQuiet
+	b.Hosts = []launcher.Host{func(_ int) string { return s.URL("/chrome.zip") }}
+	g.Err(b.Get())
+	g.False(utils.FileExists(b.BinPath()))
+}
+
 func TestIgnoreCerts(t *testing.T) {
 	g := setup(t)
 
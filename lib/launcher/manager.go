@@ -1,13 +1,17 @@
 package launcher
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httputil"
 	"net/url"
 	"os"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/yontaruron/rod/lib/cdp"
 	"github.com/yontaruron/rod/lib/launcher/flags"
@@ -36,6 +40,12 @@ func MustNewManaged(serviceURL string) *Launcher {
 // to get the default settings of the Launcher instance. For example if the launcher.Manager running on a
 // Linux machine will return different default settings from the one on Mac.
 // If Launcher.Leakless is enabled, the remote browser will be killed after the websocket is closed.
+// Use [Launcher.Client] to launch the remote browser and connect to it, such as:
+//
+//	browser := rod.New().Client(launcher.MustNewManaged("ws://a.com:7317").MustClient()).MustConnect()
+//
+// Because the remote browser lives with the websocket, a dropped connection can't be resumed,
+// use [Launcher.ReconnectClient] to launch a new remote browser and connect to it when the websocket drops.
 func NewManaged(serviceURL string) (*Launcher, error) {
 	if serviceURL == "" {
 		serviceURL = "ws://127.0.0.1:7317"
@@ -92,6 +102,171 @@ func (l *Launcher) ClientHeader() (string, http.Header) {
 	return l.serviceURL, header
 }
 
+// MustReconnectClient is similar to [Launcher.ReconnectClient].
+func (l *Launcher) MustReconnectClient(retries int) *ReconnectClient {
+	c, err := l.ReconnectClient(retries)
+	utils.E(err)
+	return c
+}
+
+// ReconnectClient is similar to [Launcher.Client], but when the websocket drops it launches
+// a new remote browser with the same settings and connects to it.
+// For each drop it tries to reconnect at most retries times with backoff, it gives up earlier if the
+// launcher.Manager rejects the launch, such as a not allowed [Launcher.Bin], check [ReconnectClient.Err] for why.
+func (l *Launcher) ReconnectClient(retries int) (*ReconnectClient, error) {
+	ctx, cancel := context.WithCancel(l.ctx)
+
+	c := &ReconnectClient{
+		launcher: l,
+		retries:  retries,
+		ctx:      ctx,
+		cancel:   cancel,
+		event:    make(chan *cdp.Event),
+	}
+
+	err := c.connect()
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	go c.consume()
+	return c, nil
+}
+
+// ReconnectClient is a cdp client for the browser launched via the launcher.Manager that survives
+// the websocket drops, it can be used as the client of rod.Browser.
+// The calls pending on a dropped connection fail with the connection error, the later calls go to the new browser.
+// Because the new browser is a different process, the pages and sessions of the old one are gone,
+// use [Launcher.KeepUserDataDir] to keep the user data, such as cookies, between them.
+// A successful "Browser.close" call stops the reconnecting, so closing the browser won't launch a new one.
+// Call [ReconnectClient.Close] when the browser is no longer used without closing it.
+type ReconnectClient struct {
+	launcher *Launcher
+	retries  int
+
+	ctx    context.Context
+	cancel func()
+
+	lock    sync.Mutex
+	ws      *cdp.WebSocket
+	client  *cdp.Client
+	closing bool
+	err     error
+
+	event chan *cdp.Event
+}
+
+// Call a method of the current connection.
+func (c *ReconnectClient) Call(ctx context.Context, sessionID, method string, params interface{}) ([]byte, error) {
+	c.lock.Lock()
+	client := c.client
+	if method == "Browser.close" {
+		c.closing = true
+	}
+	c.lock.Unlock()
+
+	res, err := client.Call(ctx, sessionID, method, params)
+	if err != nil && method == "Browser.close" {
+		c.lock.Lock()
+		c.closing = false
+		c.lock.Unlock()
+	}
+	return res, err
+}
+
+// Event returns the events of all the connections,
+// it will be closed after the browser is closed, the client is closed, or the reconnecting gives up.
+func (c *ReconnectClient) Event() <-chan *cdp.Event {
+	return c.event
+}
+
+// Close stops the reconnecting and closes the current connection, the Event channel will be closed.
+func (c *ReconnectClient) Close() error {
+	c.lock.Lock()
+	c.closing = true
+	ws := c.ws
+	c.lock.Unlock()
+
+	c.cancel()
+	return ws.Close()
+}
+
+// Err returns the error why the reconnecting gives up, it's nil if the client is closed
+// or the browser is closed by the "Browser.close" call.
+func (c *ReconnectClient) Err() error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.err
+}
+
+func (c *ReconnectClient) connect() error {
+	u, h := c.launcher.ClientHeader()
+
+	ws := &cdp.WebSocket{}
+	err := ws.Connect(c.ctx, u, h)
+	if err != nil {
+		return err
+	}
+
+	c.lock.Lock()
+	c.ws = ws
+	c.client = cdp.New().Start(ws)
+	c.lock.Unlock()
+	return nil
+}
+
+func (c *ReconnectClient) consume() {
+	defer close(c.event)
+
+	for {
+		c.lock.Lock()
+		client := c.client
+		c.lock.Unlock()
+
+		for e := range client.Event() {
+			select {
+			case <-c.ctx.Done():
+				return
+			case c.event <- e:
+			}
+		}
+
+		c.lock.Lock()
+		closing := c.closing
+		c.lock.Unlock()
+		if closing {
+			return
+		}
+
+		var last error
+		err := utils.Retry(c.ctx, utils.EachSleepers(
+			utils.CountSleeper(c.retries),
+			utils.BackoffSleeper(100*time.Millisecond, 5*time.Second, nil),
+		), func() (bool, error) {
+			last = c.connect()
+			if last == nil {
+				return true, nil
+			}
+
+			// the manager rejects the launch, retrying won't help
+			var bad *cdp.BadHandshakeError
+			return errors.As(last, &bad), last
+		})
+		if err != nil {
+			c.lock.Lock()
+			if !c.closing {
+				if last != nil && !errors.Is(err, last) {
+					err = fmt.Errorf("%w: %w", err, last)
+				}
+				c.err = err
+			}
+			c.lock.Unlock()
+			return
+		}
+	}
+}
+
 func (l *Launcher) mustManaged() {
 	if !l.managed {
 		panic("Must be used with launcher.NewManaged")
@@ -1,6 +1,8 @@
 package launcher
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net/url"
 	"os"
@@ -121,6 +123,62 @@ func TestManaged(t *testing.T) {
 	g.Eq(err.(*cdp.BadHandshakeError).Body, "[rod-manager] not allowed rod-bin path: go (use --allow-all to disable the protection)")
 }
 
+func TestManagedReconnect(t *testing.T) {
+	g := setup(t)
+
+	ctx := g.Timeout(10 * time.Second)
+
+	s := got.New(g).Serve()
+	s.Mux.Handle("/", NewManager())
+
+	c := MustNewManaged(s.URL()).Context(ctx).MustReconnectClient(10)
+	closed := make(chan struct{})
+	go func() {
+		for range c.Event() {
+			utils.Noop()
+		}
+		close(closed)
+	}()
+
+	g.E(c.Call(ctx, "", "Browser.getVersion", nil))
+	_, _ = c.Call(ctx, "", "Browser.crash", nil)
+
+	// a new browser is launched after the websocket drops
+	for ctx.Err() == nil {
+		_, err := c.Call(ctx, "", "Browser.getVersion", nil)
+		if err == nil {
+			break
+		}
+		utils.Sleep(0.1)
+	}
+	g.E(c.Call(ctx, "", "Browser.getVersion", nil))
+
+	_, _ = c.Call(ctx, "", "Browser.close", nil)
+	select {
+	case <-ctx.Done():
+		g.Fatal("the client should stop after the browser is closed")
+	case <-closed:
+	}
+	g.Nil(c.Err())
+
+	_, err := c.Call(ctx, "", "Browser.getVersion", nil)
+	g.Err(err)
+
+	// stop without closing the browser
+	c = MustNewManaged(s.URL()).Context(ctx).MustReconnectClient(10)
+	g.E(c.Call(ctx, "", "Browser.getVersion", nil))
+	g.E(c.Close())
+	for range c.Event() {
+		utils.Noop()
+	}
+	g.Nil(c.Err())
+
+	// the manager rejects the launch
+	_, err = MustNewManaged(s.URL()).Bin("go").ReconnectClient(10)
+	var bad *cdp.BadHandshakeError
+	g.True(errors.As(err, &bad))
+}
+
 func TestLaunchErrs(t *testing.T) {
 	g := setup(t)
 
@@ -152,6 +210,30 @@ func TestURLParserErr(t *testing.T) {
 	g.Eq(u.Err().Error(), "[launcher] Failed to launch the browser, the doc might help https://go-rod.github.io/#/compatibility?id=os: /tmp/rod/chromium-818858/chrome: error while loading shared libraries: libgobject-2.0.so.0: cannot open shared object file: No such file or directory")
 }
 
+func TestURLParserWaitURL(t *testing.T) {
+	g := setup(t)
+
+	r := NewURLParser()
+	exit := make(chan struct{})
+	go func() {
+		_, _ = r.Write([]byte("DevTools listening on ws://127.0.0.1:9222/devtools/browser/id\n"))
+	}()
+	u, err := r.WaitURL(exit)
+	g.E(err)
+	g.Eq(u, "http://127.0.0.1:9222")
+
+	r = NewURLParser()
+	_, _ = r.Write([]byte("bind() failed: Address already in use"))
+	close(exit)
+	_, err = r.WaitURL(exit)
+	g.Eq(err.Error(), "[launcher] Failed to get the debug url: bind() failed: Address already in use")
+
+	ctx := g.Context()
+	ctx.Cancel()
+	_, err = NewURLParser().Context(ctx).WaitURL(nil)
+	g.Is(err, context.Canceled)
+}
+
 func TestTestOpen(_ *testing.T) {
 	openExec = func(_ string, _ ...string) *exec.Cmd {
 		cmd := exec.Command("not-exists")
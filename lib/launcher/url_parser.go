@@ -69,6 +69,27 @@ func (r *URLParser) Write(p []byte) (n int, err error) {
 	return len(p), nil
 }
 
+// WaitURL waits for the debug url parsed from the browser output. The exit should be closed
+// when the browser process exits, such as:
+//
+//	exit := make(chan struct{})
+//	go func() { _ = cmd.Wait(); close(exit) }()
+//	u, err := parser.WaitURL(exit)
+//
+// If the browser exits before it prints the url, such as a bad binary or a port conflict,
+// it returns [URLParser.Err] which contains the output of the browser, rather than blocking forever.
+// It returns the ctx error if the context of the parser is done.
+func (r *URLParser) WaitURL(exit <-chan struct{}) (string, error) {
+	select {
+	case <-r.ctx.Done():
+		return "", r.ctx.Err()
+	case u := <-r.URL:
+		return u, nil
+	case <-exit:
+		return "", r.Err()
+	}
+}
+
 // Err returns the common error parsed from stdout and stderr.
 func (r *URLParser) Err() error {
 	r.lock.Lock()
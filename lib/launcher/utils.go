@@ -5,8 +5,14 @@ import (
 	"crypto/sha256"
 	"crypto/x509"
 	"encoding/base64"
+	"encoding/hex"
 	"fmt"
+	"hash"
+	"io"
+	"net/http"
 	"net/url"
+	"strings"
+	"time"
 
 	"github.com/yontaruron/rod/lib/utils"
 )
@@ -47,3 +53,83 @@ func certSPKI(pk crypto.PublicKey) ([]byte, error) {
 
 	return pin, nil
 }
+
+// checksumTransport verifies the sha256 of the response body when it's fully read,
+// the reader will get an [ErrChecksum] instead of [io.EOF] if it doesn't match.
+type checksumTransport struct {
+	http.RoundTripper
+	expected string
+}
+
+func (t *checksumTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	rt := t.RoundTripper
+	if rt == nil {
+		rt = http.DefaultTransport
+	}
+
+	res, err := rt.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	res.Body = &checksumBody{res.Body, sha256.New(), strings.ToLower(t.expected)}
+	return res, nil
+}
+
+type checksumBody struct {
+	io.ReadCloser
+	h        hash.Hash
+	expected string
+}
+
+func (b *checksumBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	_, _ = b.h.Write(p[:n])
+	if err == io.EOF {
+		if actual := hex.EncodeToString(b.h.Sum(nil)); actual != b.expected {
+			return n, &ErrChecksum{b.expected, actual}
+		}
+	}
+	return n, err
+}
+
+// progressTransport reports the progress of reading the response body,
+// at most once per span, and always when the body is fully read.
+type progressTransport struct {
+	http.RoundTripper
+	span   time.Duration
+	report func(downloaded, total int64)
+}
+
+func (t *progressTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	rt := t.RoundTripper
+	if rt == nil {
+		rt = http.DefaultTransport
+	}
+
+	res, err := rt.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	res.Body = &progressBody{ReadCloser: res.Body, t: t, total: res.ContentLength}
+	return res, nil
+}
+
+type progressBody struct {
+	io.ReadCloser
+	t     *progressTransport
+	total int64
+	count int64
+	last  time.Time
+}
+
+func (b *progressBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.count += int64(n)
+	if err == io.EOF || time.Since(b.last) >= b.t.span {
+		b.last = time.Now()
+		b.t.report(b.count, b.total)
+	}
+	return n, err
+}
@@ -58,6 +58,14 @@ func DefaultBackoff(interval time.Duration) time.Duration {
 	return time.Duration(float64(interval) * scale)
 }
 
+// ScaleBackoff returns a backoff algorithm that grows the interval by the factor: A(n) = A(n-1) * factor.
+// Use it with [BackoffSleeper] to tune the growth rate.
+func ScaleBackoff(factor float64) func(time.Duration) time.Duration {
+	return func(interval time.Duration) time.Duration {
+		return time.Duration(float64(interval) * factor)
+	}
+}
+
 // BackoffSleeper returns a sleeper that sleeps in a backoff manner every time get called.
 // The sleep interval of the sleeper will grow from initInterval to maxInterval by the specified algorithm,
 // then use maxInterval as the interval.
@@ -81,7 +89,7 @@ func BackoffSleeper(initInterval, maxInterval time.Duration, algorithm func(time
 
 		var interval time.Duration
 		if initInterval < maxInterval {
-			interval = algorithm(initInterval)
+			interval = min(algorithm(initInterval), maxInterval)
 		} else {
 			interval = maxInterval
 		}
@@ -15,6 +15,17 @@ func TestBackoffSleeperWakeNow(t *testing.T) {
 	g.E(utils.BackoffSleeper(0, 0, nil)(g.Context()))
 }
 
+func TestScaleBackoff(t *testing.T) {
+	g := setup(t)
+
+	g.Eq(utils.ScaleBackoff(1.5)(100*time.Millisecond), 150*time.Millisecond)
+
+	s := utils.BackoffSleeper(time.Millisecond, 3*time.Millisecond, utils.ScaleBackoff(10))
+	start := time.Now()
+	g.E(s(g.Context()))
+	g.Lt(time.Since(start), 10*time.Millisecond)
+}
+
 func TestRetry(t *testing.T) {
 	g := setup(t)
 
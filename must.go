@@ -79,6 +79,23 @@ func (b *Browser) MustPages() Pages {
 	return list
 }
 
+// MustWaitPage is similar to [Browser.WaitPage].
+func (b *Browser) MustWaitPage() (wait func() *Page) {
+	w := b.WaitPage()
+	return func() *Page {
+		page, err := w()
+		b.e(err)
+		return page
+	}
+}
+
+// MustWaitPageByURL is similar to [Browser.WaitPageByURL].
+func (b *Browser) MustWaitPageByURL(jsRegex string) *Page {
+	p, err := b.WaitPageByURL(jsRegex)
+	b.e(err)
+	return p
+}
+
 // MustPageFromTargetID is similar to [Browser.PageFromTargetID].
 func (b *Browser) MustPageFromTargetID(targetID proto.TargetTargetID) *Page {
 	p, err := b.PageFromTarget(targetID)
@@ -208,6 +225,12 @@ func (p *Page) MustSetCookies(cookies ...*proto.NetworkCookieParam) *Page {
 	return p
 }
 
+// MustClearCookies is similar to [Page.ClearCookies].
+func (p *Page) MustClearCookies() *Page {
+	p.e(p.ClearCookies())
+	return p
+}
+
 // MustSetExtraHeaders is similar to [Page.SetExtraHeaders].
 func (p *Page) MustSetExtraHeaders(dict ...string) (cleanup func()) {
 	cleanup, err := p.SetExtraHeaders(dict)
@@ -215,12 +238,24 @@ func (p *Page) MustSetExtraHeaders(dict ...string) (cleanup func()) {
 	return
 }
 
+// MustIgnoreCertErrors is similar to [Page.IgnoreCertErrors].
+func (p *Page) MustIgnoreCertErrors(enable bool) *Page {
+	p.e(p.IgnoreCertErrors(enable))
+	return p
+}
+
 // MustSetUserAgent is similar to [Page.SetUserAgent].
 func (p *Page) MustSetUserAgent(req *proto.NetworkSetUserAgentOverride) *Page {
 	p.e(p.SetUserAgent(req))
 	return p
 }
 
+// MustSetUserAgentString is similar to [Page.SetUserAgentString].
+func (p *Page) MustSetUserAgentString(ua, lang string) *Page {
+	p.e(p.SetUserAgentString(ua, lang))
+	return p
+}
+
 // MustSetBlockedURLs is similar to [Page.SetBlockedURLs].
 func (p *Page) MustSetBlockedURLs(urls ...string) *Page {
 	p.e(p.SetBlockedURLs(urls))
@@ -245,6 +280,12 @@ func (p *Page) MustReload() *Page {
 	return p
 }
 
+// MustReloadIgnoreCache is similar to [Page.ReloadIgnoreCache].
+func (p *Page) MustReloadIgnoreCache() *Page {
+	p.e(p.ReloadIgnoreCache())
+	return p
+}
+
 // MustActivate is similar to [Page.Activate].
 func (p *Page) MustActivate() *Page {
 	p.e(p.Activate())
@@ -325,12 +366,84 @@ func (p *Page) MustSetViewport(width, height int, deviceScaleFactor float64, mob
 	return p
 }
 
+// MustClearViewport is similar to [Page.ClearViewport].
+func (p *Page) MustClearViewport() *Page {
+	p.e(p.ClearViewport())
+	return p
+}
+
 // MustEmulate is similar to [Page.Emulate].
 func (p *Page) MustEmulate(device devices.Device) *Page {
 	p.e(p.Emulate(device))
 	return p
 }
 
+// MustEmulateTouch is similar to [Page.EmulateTouch].
+func (p *Page) MustEmulateTouch(enable bool) *Page {
+	p.e(p.EmulateTouch(enable))
+	return p
+}
+
+// MustEmulateGeolocation is similar to [Page.EmulateGeolocation].
+func (p *Page) MustEmulateGeolocation(latitude, longitude, accuracy float64) *Page {
+	p.e(p.EmulateGeolocation(latitude, longitude, accuracy))
+	return p
+}
+
+// MustClearGeolocation is similar to [Page.ClearGeolocation].
+func (p *Page) MustClearGeolocation() *Page {
+	p.e(p.ClearGeolocation())
+	return p
+}
+
+// MustEmulateTimezone is similar to [Page.EmulateTimezone].
+func (p *Page) MustEmulateTimezone(tz string) *Page {
+	p.e(p.EmulateTimezone(tz))
+	return p
+}
+
+// MustEmulateLocale is similar to [Page.EmulateLocale].
+func (p *Page) MustEmulateLocale(locale string) *Page {
+	p.e(p.EmulateLocale(locale))
+	return p
+}
+
+// MustEmulateMedia is similar to [Page.EmulateMedia].
+func (p *Page) MustEmulateMedia(media string) *Page {
+	p.e(p.EmulateMedia(media))
+	return p
+}
+
+// MustEmulateMediaFeatures is similar to [Page.EmulateMediaFeatures].
+func (p *Page) MustEmulateMediaFeatures(features []*proto.EmulationMediaFeature) *Page {
+	p.e(p.EmulateMediaFeatures(features))
+	return p
+}
+
+// MustEmulateDarkMode is similar to [Page.EmulateDarkMode].
+func (p *Page) MustEmulateDarkMode(enable bool) *Page {
+	p.e(p.EmulateDarkMode(enable))
+	return p
+}
+
+// MustEmulateCPUThrottling is similar to [Page.EmulateCPUThrottling].
+func (p *Page) MustEmulateCPUThrottling(rate float64) *Page {
+	p.e(p.EmulateCPUThrottling(rate))
+	return p
+}
+
+// MustEmulateNetworkConditions is similar to [Page.EmulateNetworkConditions].
+func (p *Page) MustEmulateNetworkConditions(offline bool, latency, downKbps, upKbps float64) *Page {
+	p.e(p.EmulateNetworkConditions(offline, latency, downKbps, upKbps))
+	return p
+}
+
+// MustOffline is similar to [Page.Offline].
+func (p *Page) MustOffline(enable bool) *Page {
+	p.e(p.Offline(enable))
+	return p
+}
+
 // MustStopLoading is similar to [Page.StopLoading].
 func (p *Page) MustStopLoading() *Page {
 	p.e(p.StopLoading())
@@ -353,6 +466,19 @@ func (p *Page) MustHandleDialog() (wait func() *proto.PageJavascriptDialogOpenin
 	}
 }
 
+// MustHandleNextDialog is similar to [Page.HandleNextDialog].
+func (p *Page) MustHandleNextDialog(accept bool, promptText string) (wait func()) {
+	w := p.HandleNextDialog(accept, promptText)
+	return func() { p.e(w()) }
+}
+
+// MustPreventContextMenu is similar to [Page.PreventContextMenu].
+func (p *Page) MustPreventContextMenu() (remove func()) {
+	r, err := p.PreventContextMenu()
+	p.e(err)
+	return func() { p.e(r()) }
+}
+
 // MustHandleFileDialog is similar to [Page.HandleFileDialog].
 func (p *Page) MustHandleFileDialog() func(...string) {
 	setFiles, err := p.HandleFileDialog()
@@ -362,6 +488,58 @@ func (p *Page) MustHandleFileDialog() func(...string) {
 	}
 }
 
+// MustDownloadFile is similar to [Page.DownloadFile].
+func (p *Page) MustDownloadFile(trigger func()) (data []byte, filename string) {
+	data, filename, err := p.DownloadFile(func() error {
+		trigger()
+		return nil
+	})
+	p.e(err)
+	return data, filename
+}
+
+// MustGetMetrics is similar to [Page.GetMetrics].
+func (p *Page) MustGetMetrics() PageMetrics {
+	m, err := p.GetMetrics()
+	p.e(err)
+	return m
+}
+
+// MustStartTracing is similar to [Page.StartTracing].
+func (p *Page) MustStartTracing(categories ...string) *Page {
+	p.e(p.StartTracing(categories))
+	return p
+}
+
+// MustStopTracing is similar to [Page.StopTracing].
+func (p *Page) MustStopTracing() []byte {
+	trace, err := p.StopTracing()
+	p.e(err)
+	return trace
+}
+
+// MustStartJSCoverage is similar to [Page.StartJSCoverage].
+func (p *Page) MustStartJSCoverage() (stop func() []*Coverage) {
+	s, err := p.StartJSCoverage()
+	p.e(err)
+	return func() []*Coverage {
+		list, err := s()
+		p.e(err)
+		return list
+	}
+}
+
+// MustStartCSSCoverage is similar to [Page.StartCSSCoverage].
+func (p *Page) MustStartCSSCoverage() (stop func() []*Coverage) {
+	s, err := p.StartCSSCoverage()
+	p.e(err)
+	return func() []*Coverage {
+		list, err := s()
+		p.e(err)
+		return list
+	}
+}
+
 // MustScreenshot is similar to [Page.Screenshot].
 // If the toFile is "", it Page.will save output to "tmp/screenshots" folder, time as the file name.
 func (p *Page) MustScreenshot(toFile ...string) []byte {
@@ -393,6 +571,14 @@ func (p *Page) MustScreenshotFullPage(toFile ...string) []byte {
 	return bin
 }
 
+// MustScreenshotClipSelector is similar to [Page.ScreenshotClipSelector].
+func (p *Page) MustScreenshotClipSelector(selector string, toFile ...string) []byte {
+	bin, err := p.ScreenshotClipSelector(selector, proto.PageCaptureScreenshotFormatPng, 0)
+	p.e(err)
+	p.e(saveFile(saveFileTypeScreenshot, bin, toFile))
+	return bin
+}
+
 // MustScrollScreenshot is similar to [Page.ScrollScreenshot].
 // If the toFile is "", it Page.will save output to "tmp/screenshots" folder, time as the file name.
 func (p *Page) MustScrollScreenshot(toFile ...string) []byte {
@@ -408,12 +594,23 @@ func (p *Page) MustPDF(toFile ...string) []byte {
 	r, err := p.PDF(&proto.PagePrintToPDF{})
 	p.e(err)
 	bin, err := io.ReadAll(r)
-	p.e(err)
+	if err != nil {
+		_ = r.Close()
+		p.e(err)
+	}
+	p.e(r.Close())
 
 	p.e(saveFile(saveFileTypePDF, bin, toFile))
 	return bin
 }
 
+// MustResourceTree is similar to [Page.ResourceTree].
+func (p *Page) MustResourceTree() *proto.PageFrameResourceTree {
+	tree, err := p.ResourceTree()
+	p.e(err)
+	return tree
+}
+
 // MustWaitOpen is similar to [Page.WaitOpen].
 func (p *Page) MustWaitOpen() (wait func() (newPage *Page)) {
 	w := p.WaitOpen()
@@ -490,6 +687,12 @@ func (p *Page) MustEval(js string, params ...interface{}) gson.JSON {
 	return res.Value
 }
 
+// MustEvalTo is similar to [Page.EvalTo].
+func (p *Page) MustEvalTo(dst interface{}, js string, params ...interface{}) *Page {
+	p.e(p.EvalTo(dst, js, params...))
+	return p
+}
+
 // MustEvaluate is similar to [Page.Evaluate].
 func (p *Page) MustEvaluate(opts *EvalOptions) *proto.RuntimeRemoteObject {
 	res, err := p.Evaluate(opts)
@@ -591,6 +794,13 @@ func (p *Page) MustElementR(selector, jsRegex string) *Element {
 	return el
 }
 
+// MustElementVisible is similar to [Page.ElementVisible].
+func (p *Page) MustElementVisible(selector string) *Element {
+	el, err := p.ElementVisible(selector)
+	p.e(err)
+	return el
+}
+
 // MustElementX is similar to [Page.ElementX].
 func (p *Page) MustElementX(xPath string) *Element {
 	el, err := p.ElementX(xPath)
@@ -612,6 +822,27 @@ func (p *Page) MustElements(selector string) Elements {
 	return list
 }
 
+// MustElementCount is similar to [Page.ElementCount].
+func (p *Page) MustElementCount(selector string) int {
+	n, err := p.ElementCount(selector)
+	p.e(err)
+	return n
+}
+
+// MustWaitElements is similar to [Page.WaitElements].
+func (p *Page) MustWaitElements(selector string, min int) Elements {
+	list, err := p.WaitElements(selector, min)
+	p.e(err)
+	return list
+}
+
+// MustWaitElementsCount is similar to [Page.WaitElementsCount].
+func (p *Page) MustWaitElementsCount(selector string, count int) Elements {
+	list, err := p.WaitElementsCount(selector, count)
+	p.e(err)
+	return list
+}
+
 // MustElementsX is similar to [Page.ElementsX].
 func (p *Page) MustElementsX(xpath string) Elements {
 	list, err := p.ElementsX(xpath)
@@ -682,6 +913,18 @@ func (k *Keyboard) MustType(key ...input.Key) *Keyboard {
 	return k
 }
 
+// MustCombo is similar to [Keyboard.Combo].
+func (k *Keyboard) MustCombo(keys ...input.Key) *Keyboard {
+	k.page.e(k.Combo(keys...))
+	return k
+}
+
+// MustTypeText is similar to [Keyboard.TypeText].
+func (k *Keyboard) MustTypeText(text string, delay time.Duration) *Keyboard {
+	k.page.e(k.TypeText(text, delay))
+	return k
+}
+
 // MustDo is similar to [KeyActions.Do].
 func (ka *KeyActions) MustDo() {
 	ka.keyboard.page.e(ka.Do())
@@ -717,6 +960,12 @@ func (t *Touch) MustCancel() *Touch {
 	return t
 }
 
+// MustSwipe is similar to [Touch.Swipe].
+func (t *Touch) MustSwipe(from, to proto.Point, steps int) *Touch {
+	t.page.e(t.Swipe(from, to, steps))
+	return t
+}
+
 // MustTap is similar to [Touch.Tap].
 func (t *Touch) MustTap(x, y float64) *Touch {
 	t.page.e(t.Tap(x, y))
@@ -738,6 +987,13 @@ func (el *Element) MustDescribe() *proto.DOMNode {
 	return node
 }
 
+// MustHasShadowRoot is similar to [Element.HasShadowRoot].
+func (el *Element) MustHasShadowRoot() bool {
+	has, err := el.HasShadowRoot()
+	el.e(err)
+	return has
+}
+
 // MustShadowRoot is similar to [Element.ShadowRoot].
 func (el *Element) MustShadowRoot() *Element {
 	node, err := el.ShadowRoot()
@@ -764,21 +1020,39 @@ func (el *Element) MustScrollIntoView() *Element {
 	return el
 }
 
+// MustScrollIntoViewAligned is similar to [Element.ScrollIntoViewAligned].
+func (el *Element) MustScrollIntoViewAligned(block, inline ScrollAlignment) *Element {
+	el.e(el.ScrollIntoViewAligned(block, inline))
+	return el
+}
+
 // MustHover is similar to [Element.Hover].
 func (el *Element) MustHover() *Element {
 	el.e(el.Hover())
 	return el
 }
 
+// MustHoverLinear is similar to [Element.HoverLinear].
+func (el *Element) MustHoverLinear(steps int) *Element {
+	el.e(el.HoverLinear(steps))
+	return el
+}
+
 // MustClick is similar to [Element.Click].
 func (el *Element) MustClick() *Element {
 	el.e(el.Click(proto.InputMouseButtonLeft, 1))
 	return el
 }
 
-// MustDoubleClick is similar to [Element.Click].
+// MustRightClick is similar to [Element.RightClick].
+func (el *Element) MustRightClick() *Element {
+	el.e(el.RightClick())
+	return el
+}
+
+// MustDoubleClick is similar to [Element.DoubleClick].
 func (el *Element) MustDoubleClick() *Element {
-	el.e(el.Click(proto.InputMouseButtonLeft, 2))
+	el.e(el.DoubleClick(proto.InputMouseButtonLeft))
 	return el
 }
 
@@ -810,6 +1084,12 @@ func (el *Element) MustType(keys ...input.Key) *Element {
 	return el
 }
 
+// MustTypeText is similar to [Element.TypeText].
+func (el *Element) MustTypeText(text string, delay time.Duration) *Element {
+	el.e(el.TypeText(text, delay))
+	return el
+}
+
 // MustKeyActions is similar to [Element.KeyActions].
 func (el *Element) MustKeyActions() *KeyActions {
 	ka, err := el.KeyActions()
@@ -835,6 +1115,18 @@ func (el *Element) MustInput(text string) *Element {
 	return el
 }
 
+// MustClear is similar to [Element.Clear].
+func (el *Element) MustClear() *Element {
+	el.e(el.Clear())
+	return el
+}
+
+// MustInputClear is similar to [Element.InputClear].
+func (el *Element) MustInputClear(text string) *Element {
+	el.e(el.InputClear(text))
+	return el
+}
+
 // MustInputTime is similar to [Element.Input].
 func (el *Element) MustInputTime(t time.Time) *Element {
 	el.e(el.InputTime(t))
@@ -859,6 +1151,24 @@ func (el *Element) MustSelect(selectors ...string) *Element {
 	return el
 }
 
+// MustSelectByText is similar to [Element.SelectByText].
+func (el *Element) MustSelectByText(texts ...string) *Element {
+	el.e(el.SelectByText(texts...))
+	return el
+}
+
+// MustSelectByValue is similar to [Element.SelectByValue].
+func (el *Element) MustSelectByValue(values ...string) *Element {
+	el.e(el.SelectByValue(values...))
+	return el
+}
+
+// MustSelectByIndex is similar to [Element.SelectByIndex].
+func (el *Element) MustSelectByIndex(indexes ...int) *Element {
+	el.e(el.SelectByIndex(indexes...))
+	return el
+}
+
 // MustMatches is similar to [Element.Matches].
 func (el *Element) MustMatches(selector string) bool {
 	res, err := el.Matches(selector)
@@ -873,6 +1183,18 @@ func (el *Element) MustAttribute(name string) *string {
 	return attr
 }
 
+// MustSetAttribute is similar to [Element.SetAttribute].
+func (el *Element) MustSetAttribute(name, value string) *Element {
+	el.e(el.SetAttribute(name, value))
+	return el
+}
+
+// MustRemoveAttribute is similar to [Element.RemoveAttribute].
+func (el *Element) MustRemoveAttribute(name string) *Element {
+	el.e(el.RemoveAttribute(name))
+	return el
+}
+
 // MustProperty is similar to [Element.Property].
 func (el *Element) MustProperty(name string) gson.JSON {
 	prop, err := el.Property(name)
@@ -880,6 +1202,19 @@ func (el *Element) MustProperty(name string) gson.JSON {
 	return prop
 }
 
+// MustSetProperty is similar to [Element.SetProperty].
+func (el *Element) MustSetProperty(name string, value interface{}) *Element {
+	el.e(el.SetProperty(name, value))
+	return el
+}
+
+// MustComputedStyle is similar to [Element.ComputedStyle].
+func (el *Element) MustComputedStyle(props ...string) map[string]string {
+	style, err := el.ComputedStyle(props...)
+	el.e(err)
+	return style
+}
+
 // MustDisabled is similar to [Element.Disabled].
 func (el *Element) MustDisabled() bool {
 	disabled, err := el.Disabled()
@@ -887,6 +1222,26 @@ func (el *Element) MustDisabled() bool {
 	return disabled
 }
 
+// MustChecked is similar to [Element.Checked].
+func (el *Element) MustChecked() bool {
+	checked, err := el.Checked()
+	el.e(err)
+	return checked
+}
+
+// MustSelected is similar to [Element.Selected].
+func (el *Element) MustSelected() bool {
+	selected, err := el.Selected()
+	el.e(err)
+	return selected
+}
+
+// MustSetChecked is similar to [Element.SetChecked].
+func (el *Element) MustSetChecked(checked bool) *Element {
+	el.e(el.SetChecked(checked))
+	return el
+}
+
 // MustContainsElement is similar to [Element.ContainsElement].
 func (el *Element) MustContainsElement(target *Element) bool {
 	contains, err := el.ContainsElement(target)
@@ -900,6 +1255,13 @@ func (el *Element) MustSetFiles(paths ...string) *Element {
 	return el
 }
 
+// MustSetFilesFromReader is similar to [Element.SetFilesFromReader].
+func (el *Element) MustSetFilesFromReader(name string, r io.Reader) (remove func()) {
+	remove, err := el.SetFilesFromReader(name, r)
+	el.e(err)
+	return remove
+}
+
 // MustSetDocumentContent is similar to [Page.SetDocumentContent].
 func (p *Page) MustSetDocumentContent(html string) *Page {
 	p.e(p.SetDocumentContent(html))
@@ -913,6 +1275,13 @@ func (el *Element) MustText() string {
 	return s
 }
 
+// MustTextContent is similar to [Element.TextContent].
+func (el *Element) MustTextContent() string {
+	s, err := el.TextContent()
+	el.e(err)
+	return s
+}
+
 // MustHTML is similar to [Element.HTML].
 func (el *Element) MustHTML() string {
 	s, err := el.HTML()
@@ -939,6 +1308,18 @@ func (el *Element) MustWaitStable() *Element {
 	return el
 }
 
+// MustWaitStableFull is similar to [Element.WaitStableFull].
+func (el *Element) MustWaitStableFull() *Element {
+	el.e(el.WaitStableFull(300 * time.Millisecond))
+	return el
+}
+
+// MustWaitStableRetry is similar to [Element.WaitStableRetry].
+func (el *Element) MustWaitStableRetry(times, maxTries int) *Element {
+	el.e(el.WaitStableRetry(300*time.Millisecond, times, maxTries))
+	return el
+}
+
 // MustWait is similar to [Element.Wait].
 func (el *Element) MustWait(js string, params ...interface{}) *Element {
 	el.e(el.Wait(Eval(js, params...)))
@@ -983,6 +1364,13 @@ func (el *Element) MustCanvasToImage() []byte {
 	return bin
 }
 
+// MustCanvasToImageWithMIME is similar to [Element.CanvasToImageWithMIME].
+func (el *Element) MustCanvasToImageWithMIME(format string, quality float64) ([]byte, string) {
+	bin, mime, err := el.CanvasToImageWithMIME(format, quality)
+	el.e(err)
+	return bin, mime
+}
+
 // MustResource is similar to [Element.Resource].
 func (el *Element) MustResource() []byte {
 	bin, err := el.Resource()
@@ -1005,6 +1393,22 @@ func (el *Element) MustScreenshot(toFile ...string) []byte {
 	return bin
 }
 
+// MustScreenshotPadded is similar to [Element.ScreenshotPadded].
+func (el *Element) MustScreenshotPadded(padding int, toFile ...string) []byte {
+	bin, err := el.ScreenshotPadded(proto.PageCaptureScreenshotFormatPng, 0, padding)
+	el.e(err)
+	el.e(saveFile(saveFileTypeScreenshot, bin, toFile))
+	return bin
+}
+
+// MustScreenshotFull is similar to [Element.ScreenshotFull].
+func (el *Element) MustScreenshotFull(toFile ...string) []byte {
+	bin, err := el.ScreenshotFull(proto.PageCaptureScreenshotFormatPng, 0)
+	el.e(err)
+	el.e(saveFile(saveFileTypeScreenshot, bin, toFile))
+	return bin
+}
+
 // MustRelease is similar to [Element.Release].
 func (el *Element) MustRelease() {
 	el.e(el.Release())
@@ -1071,6 +1475,13 @@ func (el *Element) MustParent() *Element {
 	return parent
 }
 
+// MustClosest is similar to [Element.Closest].
+func (el *Element) MustClosest(selector string) *Element {
+	parent, err := el.Closest(selector)
+	el.e(err)
+	return parent
+}
+
 // MustParents is similar to [Element.Parents].
 func (el *Element) MustParents(selector string) Elements {
 	list, err := el.Parents(selector)
@@ -1092,6 +1503,13 @@ func (el *Element) MustPrevious() *Element {
 	return parent
 }
 
+// MustSiblings is similar to [Element.Siblings].
+func (el *Element) MustSiblings() Elements {
+	list, err := el.Siblings()
+	el.e(err)
+	return list
+}
+
 // MustElementR is similar to [Element.ElementR].
 func (el *Element) MustElementR(selector, regex string) *Element {
 	sub, err := el.ElementR(selector, regex)
@@ -1149,12 +1567,32 @@ func (el *Element) MustEqual(elm *Element) bool {
 	return res
 }
 
+// MustMoveMouseTo is similar to [Element.MoveMouseTo].
+func (el *Element) MustMoveMouseTo() proto.Point {
+	pt, err := el.MoveMouseTo()
+	el.e(err)
+	return *pt
+}
+
+// MustDragTo is similar to [Element.DragTo].
+func (el *Element) MustDragTo(dst *Element) *Element {
+	el.e(el.DragTo(dst))
+	return el
+}
+
 // MustMoveMouseOut is similar to [Element.MoveMouseOut].
 func (el *Element) MustMoveMouseOut() *Element {
 	el.e(el.MoveMouseOut())
 	return el
 }
 
+// MustGetSelector is similar to [Element.GetSelector].
+func (el *Element) MustGetSelector() string {
+	selector, err := el.GetSelector()
+	el.e(err)
+	return selector
+}
+
 // MustGetXPath is similar to [Element.GetXPath].
 func (el *Element) MustGetXPath(optimized bool) string {
 	xpath, err := el.GetXPath(optimized)
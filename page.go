@@ -7,6 +7,9 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"io"
+	"os"
+	"path/filepath"
 	"sync"
 	"time"
 
@@ -56,6 +59,14 @@ type Page struct {
 
 	sleeper func() utils.Sleeper
 
+	autoRelease bool
+	objects     *objectTracker // use pointer so that page clones can share the change
+
+	isolatedWorld string
+	utility       *utilityWorlds // use pointer so that page clones can share the change
+
+	network *networkEmulation // use pointer so that page clones can share the change
+
 	browser *Browser
 	event   *goob.Observable
 
@@ -101,7 +112,9 @@ func (p *Page) Info() (*proto.TargetTargetInfo, error) {
 	return p.browser.pageInfo(p.TargetID)
 }
 
-// HTML of the page.
+// HTML of the page. It's the outer HTML of the rendered DOM after js has run,
+// which can be different from the original response body of the page.
+// The HTML is serialized by DOM.getOuterHTML instead of eval by value, so it isn't copied through the js runtime.
 func (p *Page) HTML() (string, error) {
 	el, err := p.Element("html")
 	if err != nil {
@@ -136,7 +149,31 @@ func (p *Page) SetCookies(cookies []*proto.NetworkCookieParam) error {
 	return proto.NetworkSetCookies{Cookies: cookies}.Call(p)
 }
 
+// ClearCookies deletes the cookies of the current page, the cookies of other sites won't be affected.
+// To clear all the cookies of the browser, use [Page.SetCookies] with nil.
+func (p *Page) ClearCookies() error {
+	cookies, err := p.Cookies(nil)
+	if err != nil {
+		return err
+	}
+
+	for _, c := range cookies {
+		err = proto.NetworkDeleteCookies{
+			Name:   c.Name,
+			Domain: c.Domain,
+			Path:   c.Path,
+		}.Call(p)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // SetExtraHeaders whether to always send extra HTTP headers with the requests from this page.
+// The dict is a list of key-value pairs, such as ["Authorization", "Bearer xxx", "X-Test", "1"].
+// The headers apply to all the requests of the page, including the sub-resources and the iframes
+// that share the same session. Call the returned function to stop sending them.
 func (p *Page) SetExtraHeaders(dict []string) (func(), error) {
 	headers := proto.NetworkHeaders{}
 
@@ -147,8 +184,16 @@ func (p *Page) SetExtraHeaders(dict []string) (func(), error) {
 	return p.EnableDomain(&proto.NetworkEnable{}), proto.NetworkSetExtraHTTPHeaders{Headers: headers}.Call(p)
 }
 
+// IgnoreCertErrors switch for the page, such as to visit a server with self-signed certificate.
+// If enabled, the certificate errors of the page's subsequent navigations will be ignored.
+// To apply it to all pages, use [Browser.IgnoreCertErrors] before creating them.
+func (p *Page) IgnoreCertErrors(enable bool) error {
+	return proto.SecuritySetIgnoreCertificateErrors{Ignore: enable}.Call(p)
+}
+
 // SetUserAgent (browser brand, accept-language, etc) of the page.
 // If req is nil, a default user agent will be used, a typical mac chrome.
+// It takes effect from the next request of the page, such as the next navigation.
 func (p *Page) SetUserAgent(req *proto.NetworkSetUserAgentOverride) error {
 	if req == nil {
 		req = devices.LaptopWithMDPIScreen.UserAgentEmulation()
@@ -156,6 +201,15 @@ func (p *Page) SetUserAgent(req *proto.NetworkSetUserAgentOverride) error {
 	return req.Call(p)
 }
 
+// SetUserAgentString is a shortcut for [Page.SetUserAgent] that only sets the user agent and
+// the accept-language, such as "en-US". If lang is empty, the browser's default will be used.
+func (p *Page) SetUserAgentString(ua, lang string) error {
+	return p.SetUserAgent(&proto.NetworkSetUserAgentOverride{
+		UserAgent:      ua,
+		AcceptLanguage: lang,
+	})
+}
+
 // SetBlockedURLs For some requests that do not want to be triggered,
 // such as some dangerous operations, delete, quit logout, etc.
 // Wildcards ('*') are allowed, such as ["*/api/logout/*","delete"].
@@ -177,6 +231,8 @@ func (p *Page) Navigate(url string) error {
 	// try to stop loading
 	_ = p.StopLoading()
 
+	_ = p.ReleaseAll()
+
 	res, err := proto.PageNavigate{URL: url}.Call(p)
 	if err != nil {
 		return err
@@ -190,7 +246,7 @@ func (p *Page) Navigate(url string) error {
 	return nil
 }
 
-// NavigateBack history.
+// NavigateBack history. It's a no-op if there's no previous entry.
 func (p *Page) NavigateBack() error {
 	// Not using cdp API because it doesn't work for iframe
 	_, err := p.Evaluate(Eval(`() => history.back()`).ByUser())
@@ -208,7 +264,7 @@ func (p *Page) GetNavigationHistory() (*proto.PageGetNavigationHistoryResult, er
 	return proto.PageGetNavigationHistory{}.Call(p)
 }
 
-// NavigateForward history.
+// NavigateForward history. It's a no-op if there's no next entry.
 func (p *Page) NavigateForward() error {
 	// Not using cdp API because it doesn't work for iframe
 	_, err := p.Evaluate(Eval(`() => history.forward()`).ByUser())
@@ -237,7 +293,31 @@ func (p *Page) Reload() error {
 	return nil
 }
 
-// Activate (focuses) the page.
+// ReloadIgnoreCache reloads the page and bypasses the browser cache, as if the user pressed Shift+refresh.
+// It's handy to retry a flaky load. Unlike [Page.Reload] it only works for the main frame.
+func (p *Page) ReloadIgnoreCache() error {
+	p, cancel := p.WithCancel()
+	defer cancel()
+
+	wait := p.EachEvent(func(e *proto.PageFrameNavigated) bool {
+		return e.Frame.ID == p.FrameID
+	})
+
+	err := proto.PageReload{IgnoreCache: true}.Call(p)
+	if err != nil {
+		return err
+	}
+
+	wait()
+
+	p.unsetJSCtxID()
+
+	return nil
+}
+
+// Activate (focuses) the page, it brings the tab to the foreground.
+// A background tab may throttle its timers and skip rendering, even in headless mode,
+// activate it before the interactions or screenshots that depend on animations.
 func (p *Page) Activate() (*Page, error) {
 	err := proto.TargetActivateTarget{TargetID: p.TargetID}.Call(p.browser)
 	return p, err
@@ -278,6 +358,7 @@ func (p *Page) SetWindow(bounds *proto.BrowserBounds) error {
 }
 
 // SetViewport overrides the values of device screen dimensions.
+// It affects the later screenshots and the css media queries. If params is nil, it's the same as [Page.ClearViewport].
 func (p *Page) SetViewport(params *proto.EmulationSetDeviceMetricsOverride) error {
 	if params == nil {
 		return proto.EmulationClearDeviceMetricsOverride{}.Call(p)
@@ -285,6 +366,11 @@ func (p *Page) SetViewport(params *proto.EmulationSetDeviceMetricsOverride) erro
 	return params.Call(p)
 }
 
+// ClearViewport clears the override of [Page.SetViewport], the viewport will follow the window size again.
+func (p *Page) ClearViewport() error {
+	return p.SetViewport(nil)
+}
+
 // SetDocumentContent sets the page document html content.
 func (p *Page) SetDocumentContent(html string) error {
 	return proto.PageSetDocumentContent{
@@ -294,6 +380,8 @@ func (p *Page) SetDocumentContent(html string) error {
 }
 
 // Emulate the device, such as iPhone9. If device is devices.Clear, it will clear the override.
+// It sets the viewport, device scale factor, touch, and user agent of the device at once.
+// Check the lib/devices package for the predefined devices, or use a custom [devices.Device] struct.
 func (p *Page) Emulate(device devices.Device) error {
 	err := p.SetViewport(device.MetricsEmulation())
 	if err != nil {
@@ -308,6 +396,140 @@ func (p *Page) Emulate(device devices.Device) error {
 	return p.SetUserAgent(device.UserAgentEmulation())
 }
 
+// EmulateTouch enables or disables the touch emulation of the page, it also updates the mobile flag
+// of the current viewport, because many sites gate their touch behaviors on it.
+// To emulate a whole device, use [Page.Emulate] instead.
+func (p *Page) EmulateTouch(enable bool) error {
+	err := proto.EmulationSetTouchEmulationEnabled{
+		Enabled:        enable,
+		MaxTouchPoints: gson.Int(5),
+	}.Call(p)
+	if err != nil {
+		return err
+	}
+
+	view := proto.EmulationSetDeviceMetricsOverride{}
+	p.LoadState(&view)
+	view.Mobile = enable
+
+	return p.SetViewport(&view)
+}
+
+// EmulateGeolocation overrides the position reported by navigator.geolocation.
+// It also grants the geolocation permission to the browser context, so the API resolves
+// without a prompt. Call it before the page requests the position, a pending request won't be affected.
+func (p *Page) EmulateGeolocation(latitude, longitude, accuracy float64) error {
+	err := proto.BrowserGrantPermissions{
+		Permissions:      []proto.BrowserPermissionType{proto.BrowserPermissionTypeGeolocation},
+		BrowserContextID: p.browser.BrowserContextID,
+	}.Call(p.browser)
+	if err != nil {
+		return err
+	}
+
+	return proto.EmulationSetGeolocationOverride{
+		Latitude:  &latitude,
+		Longitude: &longitude,
+		Accuracy:  &accuracy,
+	}.Call(p)
+}
+
+// ClearGeolocation clears the override set by [Page.EmulateGeolocation].
+func (p *Page) ClearGeolocation() error {
+	return proto.EmulationClearGeolocationOverride{}.Call(p)
+}
+
+// EmulateTimezone overrides the timezone of the page, such as "America/New_York" or "Asia/Tokyo".
+// The tz should be an IANA timezone id, an unknown id will make the browser return an error.
+// Use empty string to restore the timezone of the host system.
+func (p *Page) EmulateTimezone(tz string) error {
+	return proto.EmulationSetTimezoneOverride{TimezoneID: tz}.Call(p)
+}
+
+// EmulateLocale overrides the locale of the page, such as "en_US" or "fr_FR".
+// Use empty string to restore the locale of the host system.
+func (p *Page) EmulateLocale(locale string) error {
+	return proto.EmulationSetLocaleOverride{Locale: locale}.Call(p)
+}
+
+// EmulateMedia emulates the CSS media type of the page, such as "screen" or "print".
+// It's handy to screenshot the print stylesheets without generating a PDF.
+// Use empty string to disable the override.
+func (p *Page) EmulateMedia(media string) error {
+	return proto.EmulationSetEmulatedMedia{Media: media}.Call(p)
+}
+
+// EmulateMediaFeatures emulates the CSS media features of the page, such as "prefers-color-scheme"
+// or "prefers-reduced-motion". It overrides the features set by previous calls, use nil to clear them.
+func (p *Page) EmulateMediaFeatures(features []*proto.EmulationMediaFeature) error {
+	return proto.EmulationSetEmulatedMedia{Features: features}.Call(p)
+}
+
+// EmulateDarkMode forces the "prefers-color-scheme" media feature to "dark" if enable is true,
+// or "light" if enable is false.
+func (p *Page) EmulateDarkMode(enable bool) error {
+	scheme := "light"
+	if enable {
+		scheme = "dark"
+	}
+	return p.EmulateMediaFeatures([]*proto.EmulationMediaFeature{{Name: "prefers-color-scheme", Value: scheme}})
+}
+
+// EmulateCPUThrottling slows down the CPU of the page by the rate, such as 4 for 4x slowdown.
+// Use 1 to disable the throttling.
+func (p *Page) EmulateCPUThrottling(rate float64) error {
+	return proto.EmulationSetCPUThrottlingRate{Rate: rate}.Call(p)
+}
+
+// networkEmulation keeps the Network domain enabled while the network conditions are emulated,
+// because the browser drops the emulation when the domain is disabled.
+type networkEmulation struct {
+	lock    sync.Mutex
+	restore func()
+}
+
+// EmulateNetworkConditions throttles the network of the page.
+// The latency is in milliseconds, the downKbps and upKbps are in kilobits per second,
+// a value less than or equal to 0 disables the throttling of that direction.
+// The Network domain will be enabled for the page while the conditions are emulated,
+// it will be restored once the conditions are reset, such as Offline(false).
+func (p *Page) EmulateNetworkConditions(offline bool, latency, downKbps, upKbps float64) error {
+	p.network.lock.Lock()
+	defer p.network.lock.Unlock()
+
+	if p.network.restore == nil {
+		p.network.restore = p.EnableDomain(&proto.NetworkEnable{})
+	}
+
+	reset := !offline && latency <= 0 && downKbps <= 0 && upKbps <= 0
+	defer func() {
+		if reset {
+			p.network.restore()
+			p.network.restore = nil
+		}
+	}()
+
+	throughput := func(kbps float64) float64 {
+		if kbps <= 0 {
+			return -1
+		}
+		return kbps * 1000 / 8
+	}
+
+	return proto.NetworkEmulateNetworkConditions{
+		Offline:            offline,
+		Latency:            latency,
+		DownloadThroughput: throughput(downKbps),
+		UploadThroughput:   throughput(upKbps),
+	}.Call(p)
+}
+
+// Offline simulates the internet disconnection of the page if enable is true,
+// it's a shortcut for [Page.EmulateNetworkConditions] without throttling.
+func (p *Page) Offline(enable bool) error {
+	return p.EmulateNetworkConditions(enable, 0, 0, 0)
+}
+
 // StopLoading forces the page stop navigation and pending resource fetches.
 func (p *Page) StopLoading() error {
 	return proto.PageStopLoading{}.Call(p)
@@ -407,8 +629,79 @@ func (p *Page) HandleDialog() (
 		}
 }
 
+// HandleNextDialog handles the next JavaScript initiated dialog in the background with accept and promptText,
+// so the action that opens the dialog won't block. The wait returns the error of handling the dialog.
+// For example:
+//
+//	wait := page.HandleNextDialog(true, "")
+//	page.MustElement("button").MustClick()
+//	_ = wait()
+//
+// If no dialog opens, the background goroutine and the wait will block until the page context ends,
+// the Page domain enabled for the dialog is restored only after that.
+// Use [Page.Timeout] to limit how long to wait for the dialog, such as:
+//
+//	wait := page.Timeout(5 * time.Second).HandleNextDialog(true, "")
+func (p *Page) HandleNextDialog(accept bool, promptText string) (wait func() error) {
+	w, h := p.HandleDialog()
+
+	done := make(chan error, 1)
+	go func() {
+		w()
+		done <- h(&proto.PageHandleJavaScriptDialog{
+			Accept:     accept,
+			PromptText: promptText,
+		})
+	}()
+
+	return func() error { return <-done }
+}
+
+// PreventContextMenu prevents the native context menu from showing up on the current document and the future
+// documents of the page, the "contextmenu" events will still be dispatched to the page.
+// Call remove to stop preventing.
+func (p *Page) PreventContextMenu() (remove func() error, err error) {
+	name := "_" + utils.RandString(8)
+
+	const prevent = `name => {
+		window[name] = e => e.preventDefault()
+		window.addEventListener('contextmenu', window[name], true)
+	}`
+
+	_, err = p.Evaluate(Eval(prevent, name))
+	if err != nil {
+		return
+	}
+
+	removeScript, err := p.EvalOnNewDocument(fmt.Sprintf(`(%s)("%s")`, prevent, name))
+	if err != nil {
+		return
+	}
+
+	remove = func() error {
+		err := removeScript()
+		if err != nil {
+			return err
+		}
+
+		_, err = p.Evaluate(Eval(`name => {
+			window.removeEventListener('contextmenu', window[name], true)
+			delete window[name]
+		}`, name))
+		return err
+	}
+
+	return
+}
+
 // HandleFileDialog return a functions that waits for the next file chooser dialog pops up and returns the element
 // for the event.
+// The native dialog is intercepted, so it also works for the widgets that open the picker programmatically
+// without a visible file input, such as a drop zone that calls input.click():
+//
+//	setFiles := page.MustHandleFileDialog()
+//	page.MustElement(".drop-zone").MustClick()
+//	setFiles("a.png")
 func (p *Page) HandleFileDialog() (func([]string) error, error) {
 	err := proto.PageSetInterceptFileChooserDialog{Enabled: true}.Call(p)
 	if err != nil {
@@ -433,6 +726,100 @@ func (p *Page) HandleFileDialog() (func([]string) error, error) {
 	}, nil
 }
 
+// DownloadFile runs the trigger, such as clicking an "Export" button, and waits for the download it starts.
+// It returns the content and the suggested filename of the downloaded file.
+// The file is saved into a temp dir during the download, which will be removed before it returns.
+func (p *Page) DownloadFile(trigger func() error) (data []byte, filename string, err error) {
+	dir, err := os.MkdirTemp("", "rod-download-")
+	if err != nil {
+		return nil, "", err
+	}
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	ctx, cancel := context.WithCancel(p.ctx)
+	defer cancel()
+
+	wait := p.browser.Context(ctx).WaitDownload(dir)
+
+	err = trigger()
+	if err != nil {
+		cancel()
+		wait()
+		return nil, "", err
+	}
+
+	info := wait()
+	if info == nil {
+		return nil, "", p.ctx.Err()
+	}
+
+	data, err = os.ReadFile(filepath.Join(dir, info.GUID))
+	if err != nil {
+		return nil, "", err
+	}
+
+	return data, info.SuggestedFilename, nil
+}
+
+// PageMetrics is the run-time metrics of a page, keyed by the CDP metric names,
+// such as "ScriptDuration", "LayoutCount", "JSHeapUsedSize", or "FirstMeaningfulPaint".
+type PageMetrics map[string]float64
+
+// GetMetrics returns the current run-time metrics of the page.
+// The Performance domain will be enabled during the call if it's not yet.
+func (p *Page) GetMetrics() (PageMetrics, error) {
+	restore := p.EnableDomain(&proto.PerformanceEnable{})
+	defer restore()
+
+	res, err := proto.PerformanceGetMetrics{}.Call(p)
+	if err != nil {
+		return nil, err
+	}
+
+	metrics := PageMetrics{}
+	for _, m := range res.Metrics {
+		metrics[m.Name] = m.Value
+	}
+	return metrics, nil
+}
+
+// StartTracing starts to record a Chrome trace of the page with the categories, such as "devtools.timeline".
+// If categories is nil, the default categories of the browser will be used.
+// Use [Page.StopTracing] to end it and get the trace.
+func (p *Page) StartTracing(categories []string) error {
+	return proto.TracingStart{
+		TransferMode: proto.TracingStartTransferModeReturnAsStream,
+		TraceConfig:  &proto.TracingTraceConfig{IncludedCategories: categories},
+	}.Call(p)
+}
+
+// StopTracing ends the tracing started by [Page.StartTracing] and returns the trace in JSON,
+// which can be loaded by chrome://tracing or the Performance panel of the devtools.
+// The trace is read from a stream, so a large trace isn't limited by the size of a CDP message.
+func (p *Page) StopTracing() ([]byte, error) {
+	p, cancel := p.WithCancel()
+	defer cancel()
+
+	var e proto.TracingTracingComplete
+	wait := p.WaitEvent(&e)
+
+	err := proto.TracingEnd{}.Call(p)
+	if err != nil {
+		return nil, err
+	}
+
+	wait()
+
+	if e.Stream == "" {
+		return nil, p.ctx.Err()
+	}
+
+	r := NewStreamReader(p, e.Stream)
+	defer func() { _ = r.Close() }()
+
+	return io.ReadAll(r)
+}
+
 // Screenshot captures the screenshot of current page.
 func (p *Page) Screenshot(fullPage bool, req *proto.PageCaptureScreenshot) ([]byte, error) {
 	if req == nil {
@@ -476,6 +863,48 @@ func (p *Page) Screenshot(fullPage bool, req *proto.PageCaptureScreenshot) ([]by
 	return shot.Data, nil
 }
 
+// ScreenshotFullPage captures the screenshot of the whole page, not just the viewport.
+// It temporarily resizes the viewport to the size of the page content, and restores it after the capture.
+// If scroll is true, it will scroll to the bottom of the page step by step and wait for the images to load
+// before the capture, such as to trigger the lazy-loaded images, then scroll back to the top.
+func (p *Page) ScreenshotFullPage(format proto.PageCaptureScreenshotFormat, quality int, scroll bool) ([]byte, error) {
+	if scroll {
+		_, err := p.Evaluate(Eval(`async () => {
+			const frame = () => new Promise(r => requestAnimationFrame(r))
+			for (let y = 0; y < document.documentElement.scrollHeight; y += innerHeight) {
+				scrollTo(0, y)
+				await frame()
+			}
+			await Promise.all(Array.from(document.images).filter(img => !img.complete).map(img =>
+				new Promise(r => { img.onload = img.onerror = r })
+			))
+			scrollTo(0, 0)
+		}`).ByPromise())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return p.Screenshot(true, &proto.PageCaptureScreenshot{
+		Format:  format,
+		Quality: gson.Int(quality),
+	})
+}
+
+// ScreenshotClipSelector captures the screenshot of the element that matches the css selector,
+// it's a shortcut for [Page.ElementVisible] and [Element.Screenshot].
+// If multiple elements match the selector, the first visible one will be captured.
+// It retries with the page sleeper until a visible match appears, the hidden matches are
+// skipped in the browser so no remote object will be created for them.
+func (p *Page) ScreenshotClipSelector(selector string, format proto.PageCaptureScreenshotFormat, quality int) ([]byte, error) {
+	el, err := p.ElementVisible(selector)
+	if err != nil {
+		return nil, err
+	}
+
+	return el.Screenshot(format, quality)
+}
+
 // ScrollScreenshotOptions is the options for the ScrollScreenshot.
 type ScrollScreenshotOptions struct {
 	// Format (optional) Image compression format (defaults to png).
@@ -613,8 +1042,16 @@ func (p *Page) CaptureDOMSnapshot() (domSnapshot *proto.DOMSnapshotCaptureSnapsh
 	return snapshot, nil
 }
 
-// PDF prints page as PDF.
+// PDF prints page as PDF. Use the req to set the print options, such as Landscape, PaperWidth,
+// MarginTop, Scale, PageRanges, HeaderTemplate, etc.
+// If req is nil, the background graphics will be printed, so that the output looks the same as the screen.
+// The PDF is returned as a stream, so that large documents won't be buffered in memory,
+// remember to close the stream when done or on error to release the stream handle of the browser.
 func (p *Page) PDF(req *proto.PagePrintToPDF) (*StreamReader, error) {
+	if req == nil {
+		req = &proto.PagePrintToPDF{PrintBackground: true}
+	}
+
 	req.TransferMode = proto.PagePrintToPDFTransferModeReturnAsStream
 	res, err := req.Call(p)
 	if err != nil {
@@ -624,8 +1061,19 @@ func (p *Page) PDF(req *proto.PagePrintToPDF) (*StreamReader, error) {
 	return NewStreamReader(p, res.Stream), nil
 }
 
+// ResourceTree returns the frame tree of the page with the resources each frame has loaded,
+// such as images, scripts, and stylesheets.
+// Use [Page.GetResource] to get the content of a resource by its url.
+func (p *Page) ResourceTree() (*proto.PageFrameResourceTree, error) {
+	res, err := proto.PageGetResourceTree{}.Call(p)
+	if err != nil {
+		return nil, err
+	}
+	return res.FrameTree, nil
+}
+
 // GetResource content by the url. Such as image, css, html, etc.
-// Use the [proto.PageGetResourceTree] to list all the resources.
+// Use the [Page.ResourceTree] to list all the resources.
 func (p *Page) GetResource(url string) ([]byte, error) {
 	res, err := proto.PageGetResourceContent{
 		FrameID: p.FrameID,
@@ -685,18 +1133,34 @@ func (p *Page) EachEvent(callbacks ...interface{}) (wait func()) {
 }
 
 // WaitEvent waits for the next event for one time. It will also load the data into the event object.
+// It subscribes the event immediately, the returned wait function blocks until the event arrives
+// or the context of the page is done, then it unsubscribes. Use [Page.Timeout] to limit the wait,
+// and check [Page.GetContext] Err() after the wait returns to know whether the event arrived.
+// It works for any CDP event, such as [proto.PageJavascriptDialogOpening] or [proto.TargetTargetCreated]:
+//
+//	e := &proto.TargetTargetCreated{}
+//	wait := page.WaitEvent(e)
+//	page.MustElement("a[target=_blank]").MustClick()
+//	wait()
+//	fmt.Println(e.TargetInfo.URL)
 func (p *Page) WaitEvent(e proto.Event) (wait func()) {
 	defer p.tryTrace(TraceTypeWait, "event", e.ProtoEvent())()
 	return p.browser.Context(p.ctx).waitEvent(p.SessionID, e)
 }
 
-// WaitNavigation wait for a page lifecycle event when navigating.
+// WaitNavigation wait for a page lifecycle event of the page's frame when navigating,
+// the events from the child frames are ignored.
 // Usually you will wait for [proto.PageLifecycleEventNameNetworkAlmostIdle].
+// Call it before the action that triggers the navigation, or a fast navigation may be missed:
+//
+//	wait := page.WaitNavigation(proto.PageLifecycleEventNameDOMContentLoaded)
+//	page.MustElement("a").MustClick()
+//	wait()
 func (p *Page) WaitNavigation(name proto.PageLifecycleEventName) func() {
 	_ = proto.PageSetLifecycleEventsEnabled{Enabled: true}.Call(p)
 
 	wait := p.EachEvent(func(e *proto.PageLifecycleEvent) bool {
-		return e.Name == name
+		return e.FrameID == p.FrameID && e.Name == name
 	})
 
 	return func() {
@@ -710,6 +1174,9 @@ func (p *Page) WaitNavigation(name proto.PageLifecycleEventName) func() {
 // Be careful, d is not the max wait timeout, it's the least idle time.
 // If you want to set a timeout you can use the [Page.Timeout] function.
 // Use the includes and excludes regexp list to filter the requests by their url.
+// A plain substring is also a valid regexp, such as excludes []string{"analytics", "/beacon"}
+// to ignore the tracking requests that may never finish.
+// It's useful for the SPA that keeps fetching after the load event.
 func (p *Page) WaitRequestIdle(
 	d time.Duration,
 	includes, excludes []string,
@@ -817,7 +1284,11 @@ func (p *Page) WaitDOMStable(d time.Duration, diff float64) error {
 	return nil
 }
 
-// WaitStable waits until the page is stable for d duration.
+// WaitStable waits until the page is stable for d duration, it combines [Page.WaitLoad],
+// [Page.WaitRequestIdle] and [Page.WaitDOMStable] with zero diff.
+// Be careful, d is not the max wait timeout, it's the least stable time.
+// For a page that keeps changing, such as one with a live clock, it will never be stable,
+// use [Page.WaitDOMStable] with a diff tolerance or set a timeout with [Page.Timeout] instead.
 func (p *Page) WaitStable(d time.Duration) error {
 	defer p.tryTrace(TraceTypeWait, "stable")()
 
@@ -826,13 +1297,15 @@ func (p *Page) WaitStable(d time.Duration) error {
 	setErr := sync.Once{}
 
 	utils.All(func() {
-		e := p.WaitLoad()
-		setErr.Do(func() { err = e })
+		if e := p.WaitLoad(); e != nil {
+			setErr.Do(func() { err = e })
+		}
 	}, func() {
 		p.WaitRequestIdle(d, nil, nil, nil)()
 	}, func() {
-		e := p.WaitDOMStable(d, 0)
-		setErr.Do(func() { err = e })
+		if e := p.WaitDOMStable(d, 0); e != nil {
+			setErr.Do(func() { err = e })
+		}
 	})()
 
 	return err
@@ -876,6 +1349,8 @@ func (p *Page) AddStyleTag(url, content string) error {
 }
 
 // EvalOnNewDocument Evaluates given script in every frame upon creation (before loading frame's scripts).
+// It takes effect from the next navigation of the page, such as to patch navigator.webdriver or inject test hooks.
+// Call the returned remove function to stop evaluating the script on later navigations.
 func (p *Page) EvalOnNewDocument(js string) (remove func() error, err error) {
 	res, err := proto.PageAddScriptToEvaluateOnNewDocument{Source: js}.Call(p)
 	if err != nil {
@@ -928,6 +1403,8 @@ func (p *Page) ObjectToJSON(obj *proto.RuntimeRemoteObject) (gson.JSON, error) {
 // ElementFromObject creates an Element from the remote object id.
 func (p *Page) ElementFromObject(obj *proto.RuntimeRemoteObject) (*Element, error) {
 	// If the element is in an iframe, we need the jsCtxID to inject helper.js to the correct context.
+	// The owner window is resolved from the object itself with a single call, no matter how deep the iframe is,
+	// so there's no need to walk or cache the frame tree, and a navigation can't make it stale.
 	id, err := p.jsCtxIDByObjectID(obj.ObjectID)
 	if err != nil {
 		return nil, err
@@ -944,13 +1421,19 @@ func (p *Page) ElementFromObject(obj *proto.RuntimeRemoteObject) (*Element, erro
 		p = &clone
 	}
 
-	return &Element{
+	el := &Element{
 		e:       p.e,
 		ctx:     p.ctx,
 		sleeper: p.sleeper,
 		page:    p,
 		Object:  obj,
-	}, nil
+	}
+
+	if p.autoRelease {
+		p.objects.track(obj.ObjectID)
+	}
+
+	return el, nil
 }
 
 // ElementFromNode creates an Element from the node, [proto.DOMNodeID] or [proto.DOMBackendNodeID] must be specified.
@@ -985,6 +1468,8 @@ func (p *Page) ElementFromNode(node *proto.DOMNode) (*Element, error) {
 
 // ElementFromPoint creates an Element from the absolute point on the page.
 // The point should include the window scroll offset.
+// It returns the topmost element at the point, so it's useful for hit-testing, such as to check
+// if a click at the point will be intercepted by an overlay. The [Element.Interactable] uses it for the same purpose.
 func (p *Page) ElementFromPoint(x, y int) (*Element, error) {
 	node, err := proto.DOMGetNodeForLocation{X: x, Y: y}.Call(p)
 	if err != nil {
@@ -1000,10 +1485,65 @@ func (p *Page) ElementFromPoint(x, y int) (*Element, error) {
 // When a page is closed or reloaded, all remote objects will be released automatically.
 // It's useful if the page never closes or reloads.
 func (p *Page) Release(obj *proto.RuntimeRemoteObject) error {
+	p.objects.untrack(obj.ObjectID)
 	err := proto.RuntimeReleaseObject{ObjectID: obj.ObjectID}.Call(p)
 	return err
 }
 
+// AutoRelease returns a clone that tracks the remote object of each element it creates.
+// The tracked objects are released by [Page.ReleaseAll], or before each [Page.Navigate] of the page or its clones.
+// It's useful for a long-running page that never closes or reloads, such as a scraper that creates lots of elements.
+// Don't use the tracked elements after they are released.
+func (p *Page) AutoRelease(enable bool) *Page {
+	newObj := *p
+	newObj.autoRelease = enable
+	return &newObj
+}
+
+// ReleaseAll releases the remote objects of the elements tracked by [Page.AutoRelease].
+// The objects that are already gone, such as after a reload, are skipped.
+func (p *Page) ReleaseAll() error {
+	for _, id := range p.objects.drain() {
+		err := proto.RuntimeReleaseObject{ObjectID: id}.Call(p)
+		if err != nil && !errors.Is(err, cdp.ErrObjNotFound) {
+			return err
+		}
+	}
+	return nil
+}
+
+// objectTracker is shared by the clones of a page to track the remote objects created by [Page.AutoRelease].
+type objectTracker struct {
+	lock sync.Mutex
+	ids  map[proto.RuntimeRemoteObjectID]struct{}
+}
+
+func (t *objectTracker) track(id proto.RuntimeRemoteObjectID) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if t.ids == nil {
+		t.ids = map[proto.RuntimeRemoteObjectID]struct{}{}
+	}
+	t.ids[id] = struct{}{}
+}
+
+func (t *objectTracker) untrack(id proto.RuntimeRemoteObjectID) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	delete(t.ids, id)
+}
+
+func (t *objectTracker) drain() []proto.RuntimeRemoteObjectID {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	list := make([]proto.RuntimeRemoteObjectID, 0, len(t.ids))
+	for id := range t.ids {
+		list = append(list, id)
+	}
+	t.ids = nil
+	return list
+}
+
 // Call implements the [proto.Client].
 func (p *Page) Call(ctx context.Context, sessionID, methodName string, params interface{}) (res []byte, err error) {
 	return p.browser.Call(ctx, sessionID, methodName, params)
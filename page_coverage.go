@@ -0,0 +1,240 @@
+// This file serves for the JS and CSS coverage of a page.
+
+package rod
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/yontaruron/rod/lib/proto"
+)
+
+// Coverage of a JS script or a CSS style sheet.
+type Coverage struct {
+	// URL of the script or style sheet.
+	URL string
+
+	// Text is the source of the script or style sheet.
+	Text string
+
+	// Ranges of the Text that are used, sorted and disjoint.
+	Ranges []CoverageRange
+}
+
+// CoverageRange is a range of the source, the End is exclusive.
+// The offsets are in UTF-16 code units, the same as the string index in JS.
+type CoverageRange struct {
+	Start int
+	End   int
+}
+
+// Used returns the total length of the used ranges, compare it with the length of the Text
+// to get the ratio of the unused code.
+func (c *Coverage) Used() int {
+	n := 0
+	for _, r := range c.Ranges {
+		n += r.End - r.Start
+	}
+	return n
+}
+
+// StartJSCoverage starts to collect the JS coverage of the page, only the scripts parsed after it starts are reported.
+// Call the returned stop to end it and get the coverage of each script.
+func (p *Page) StartJSCoverage() (stop func() ([]*Coverage, error), err error) {
+	restore := p.EnableDomain(&proto.DebuggerEnable{})
+
+	err = proto.DebuggerSetSkipAllPauses{Skip: true}.Call(p)
+	if err != nil {
+		restore()
+		return nil, err
+	}
+
+	lock := sync.Mutex{}
+	scripts := map[proto.RuntimeScriptID]string{}
+
+	ctxPage, cancel := p.WithCancel()
+	wait := ctxPage.EachEvent(func(e *proto.DebuggerScriptParsed) {
+		if e.URL == "" {
+			return
+		}
+		lock.Lock()
+		defer lock.Unlock()
+		scripts[e.ScriptID] = e.URL
+	})
+	go wait()
+
+	err = proto.ProfilerEnable{}.Call(p)
+	if err == nil {
+		_, err = proto.ProfilerStartPreciseCoverage{Detailed: true}.Call(p)
+	}
+	if err != nil {
+		cancel()
+		restore()
+		return nil, err
+	}
+
+	return func() ([]*Coverage, error) {
+		defer restore()
+		defer cancel()
+
+		res, err := proto.ProfilerTakePreciseCoverage{}.Call(p)
+		_ = proto.ProfilerStopPreciseCoverage{}.Call(p)
+		_ = proto.ProfilerDisable{}.Call(p)
+		if err != nil {
+			return nil, err
+		}
+
+		lock.Lock()
+		defer lock.Unlock()
+
+		list := []*Coverage{}
+		for _, script := range res.Result {
+			url, has := scripts[script.ScriptID]
+			if !has {
+				continue
+			}
+
+			src, err := proto.DebuggerGetScriptSource{ScriptID: script.ScriptID}.Call(p)
+			if err != nil {
+				return nil, err
+			}
+
+			ranges := []CoverageRange{}
+			counts := []int{}
+			for _, fn := range script.Functions {
+				for _, r := range fn.Ranges {
+					ranges = append(ranges, CoverageRange{r.StartOffset, r.EndOffset})
+					counts = append(counts, r.Count)
+				}
+			}
+
+			list = append(list, &Coverage{
+				URL:    url,
+				Text:   src.ScriptSource,
+				Ranges: usedRanges(ranges, counts),
+			})
+		}
+
+		return list, nil
+	}, nil
+}
+
+// StartCSSCoverage starts to collect the CSS rule usage of the page,
+// only the style sheets added after it starts are reported.
+// Call the returned stop to end it and get the coverage of each style sheet.
+func (p *Page) StartCSSCoverage() (stop func() ([]*Coverage, error), err error) {
+	restoreDOM := p.EnableDomain(&proto.DOMEnable{})
+	restoreCSS := p.EnableDomain(&proto.CSSEnable{})
+	restore := func() {
+		restoreCSS()
+		restoreDOM()
+	}
+
+	lock := sync.Mutex{}
+	sheets := map[proto.CSSStyleSheetID]string{}
+	order := []proto.CSSStyleSheetID{}
+
+	ctxPage, cancel := p.WithCancel()
+	wait := ctxPage.EachEvent(func(e *proto.CSSStyleSheetAdded) {
+		if e.Header.SourceURL == "" {
+			return
+		}
+		lock.Lock()
+		defer lock.Unlock()
+		sheets[e.Header.StyleSheetID] = e.Header.SourceURL
+		order = append(order, e.Header.StyleSheetID)
+	})
+	go wait()
+
+	err = proto.CSSStartRuleUsageTracking{}.Call(p)
+	if err != nil {
+		cancel()
+		restore()
+		return nil, err
+	}
+
+	return func() ([]*Coverage, error) {
+		defer restore()
+		defer cancel()
+
+		res, err := proto.CSSStopRuleUsageTracking{}.Call(p)
+		if err != nil {
+			return nil, err
+		}
+
+		lock.Lock()
+		defer lock.Unlock()
+
+		used := map[proto.CSSStyleSheetID][]CoverageRange{}
+		for _, rule := range res.RuleUsage {
+			if rule.Used {
+				used[rule.StyleSheetID] = append(used[rule.StyleSheetID],
+					CoverageRange{int(rule.StartOffset), int(rule.EndOffset)})
+			}
+		}
+
+		list := []*Coverage{}
+		for _, id := range order {
+			text, err := proto.CSSGetStyleSheetText{StyleSheetID: id}.Call(p)
+			if err != nil {
+				return nil, err
+			}
+
+			counts := make([]int, len(used[id]))
+			for i := range counts {
+				counts[i] = 1
+			}
+
+			list = append(list, &Coverage{
+				URL:    sheets[id],
+				Text:   text.Text,
+				Ranges: usedRanges(used[id], counts),
+			})
+		}
+
+		return list, nil
+	}, nil
+}
+
+// usedRanges converts the possibly nested ranges into sorted disjoint ranges that have a positive count.
+// A nested range overrides the count of its outer range, such as a function that's never called inside a script.
+func usedRanges(ranges []CoverageRange, counts []int) []CoverageRange {
+	type item struct {
+		CoverageRange
+		count int
+	}
+
+	items := make([]item, len(ranges))
+	size := 0
+	for i, r := range ranges {
+		items[i] = item{r, counts[i]}
+		size = max(size, r.End)
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		if items[i].Start != items[j].Start {
+			return items[i].Start < items[j].Start
+		}
+		return items[i].End > items[j].End
+	})
+
+	marks := make([]bool, size)
+	for _, it := range items {
+		for i := it.Start; i < it.End; i++ {
+			marks[i] = it.count > 0
+		}
+	}
+
+	list := []CoverageRange{}
+	for i := 0; i < size; i++ {
+		if !marks[i] {
+			continue
+		}
+		start := i
+		for i < size && marks[i] {
+			i++
+		}
+		list = append(list, CoverageRange{start, i})
+	}
+	return list
+}
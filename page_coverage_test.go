@@ -0,0 +1,79 @@
+package rod_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/yontaruron/rod/lib/proto"
+)
+
+func TestPageJSCoverage(t *testing.T) {
+	g := setup(t)
+
+	p := g.newPage()
+	stop := p.MustStartJSCoverage()
+
+	u := g.html(`<html><script>
+		function used() { return 1 }
+		function unused() { return 2 }
+		used()
+	</script></html>`)
+	p.MustNavigate(u).MustWaitLoad()
+
+	list := stop()
+	g.Len(list, 1)
+
+	c := list[0]
+	g.Eq(u, c.URL)
+	g.Has(c.Text, "function unused()")
+	g.Lt(c.Used(), len(c.Text))
+
+	used := ""
+	for _, r := range c.Ranges {
+		used += c.Text[r.Start:r.End]
+	}
+	g.Has(used, "return 1")
+	g.False(strings.Contains(used, "return 2"))
+
+	g.mc.stubErr(1, proto.DebuggerSetSkipAllPauses{})
+	g.Err(p.StartJSCoverage())
+
+	g.mc.stubErr(1, proto.ProfilerStartPreciseCoverage{})
+	g.Err(p.StartJSCoverage())
+
+	s, err := p.StartJSCoverage()
+	g.E(err)
+	g.mc.stubErr(1, proto.ProfilerTakePreciseCoverage{})
+	g.Err(s())
+}
+
+func TestPageCSSCoverage(t *testing.T) {
+	g := setup(t)
+
+	p := g.newPage()
+	stop := p.MustStartCSSCoverage()
+
+	u := g.html(`<html><style>
+		.used { color: red; }
+		.unused { color: blue; }
+	</style><body><div class="used"></div></body></html>`)
+	p.MustNavigate(u).MustWaitLoad()
+
+	list := stop()
+	g.Len(list, 1)
+
+	c := list[0]
+	g.Eq(u, c.URL)
+	g.Len(c.Ranges, 1)
+	used := c.Text[c.Ranges[0].Start:c.Ranges[0].End]
+	g.Has(used, ".used")
+	g.False(strings.Contains(used, ".unused"))
+
+	g.mc.stubErr(1, proto.CSSStartRuleUsageTracking{})
+	g.Err(p.StartCSSCoverage())
+
+	s, err := p.StartCSSCoverage()
+	g.E(err)
+	g.mc.stubErr(1, proto.CSSStopRuleUsageTracking{})
+	g.Err(s())
+}
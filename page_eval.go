@@ -3,9 +3,11 @@
 package rod
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/yontaruron/rod/lib/cdp"
@@ -91,7 +93,12 @@ func (e *EvalOptions) This(obj *proto.RuntimeRemoteObject) *EvalOptions {
 	return e
 }
 
-// ByObject disables ByValue.
+// ByObject disables ByValue. The result will be a handle of the remote js object,
+// pass it as an arg or [EvalOptions.This] of later evals to reuse it without copying its value, such as:
+//
+//	list := page.MustEvaluate(rod.Eval(`() => Array(1e6).fill(1)`).ByObject())
+//	page.MustEval(`l => l.length`, list)
+//	page.MustRelease(list)
 func (e *EvalOptions) ByObject() *EvalOptions {
 	e.ByValue = false
 	return e
@@ -115,10 +122,39 @@ func (e *EvalOptions) formatToJSFunc() string {
 }
 
 // Eval is a shortcut for [Page.Evaluate] with AwaitPromise, ByValue set to true.
+// So an async function or a returned promise will be awaited, such as:
+//
+//	page.MustEval(`async () => (await fetch('/data')).status`)
+//
+// If the js throws or the promise is rejected, the error will be an [*EvalError]
+// that contains the JS stack.
 func (p *Page) Eval(js string, args ...interface{}) (*proto.RuntimeRemoteObject, error) {
 	return p.Evaluate(Eval(js, args...).ByPromise())
 }
 
+// EvalTo is similar to [Page.Eval], but it unmarshals the result into dst, such as:
+//
+//	var list []struct{ Title string }
+//	page.MustEvalTo(&list, `() => [...document.links].map(a => ({ title: a.title }))`)
+//
+// It returns an error if the js returns undefined or the result can't be unmarshaled into dst.
+func (p *Page) EvalTo(dst interface{}, js string, args ...interface{}) error {
+	res, err := p.Eval(js, args...)
+	if err != nil {
+		return err
+	}
+
+	if res.Type == proto.RuntimeRemoteObjectTypeUndefined {
+		return errors.New("eval js returns undefined, can't unmarshal it")
+	}
+
+	err = json.Unmarshal([]byte(res.Value.JSON("", "")), dst)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal the eval result: %w", err)
+	}
+	return nil
+}
+
 // Evaluate js on the page.
 func (p *Page) Evaluate(opts *EvalOptions) (res *proto.RuntimeRemoteObject, err error) {
 	var backoff utils.Sleeper
@@ -182,7 +218,9 @@ func (p *Page) evaluate(opts *EvalOptions) (*proto.RuntimeRemoteObject, error) {
 }
 
 // Expose fn to the page's window object with the name. The exposure survives reloads.
-// Call stop to unbind the fn.
+// The exposed function returns a promise in the page, it resolves with the result of fn,
+// or rejects with the error message of fn. Each call runs in its own goroutine,
+// so a slow call won't block the others. Call stop to unbind the fn.
 func (p *Page) Expose(name string, fn func(gson.JSON) (interface{}, error)) (stop func() error, err error) {
 	bind := "_" + utils.RandString(8)
 
@@ -215,10 +253,17 @@ func (p *Page) Expose(name string, fn func(gson.JSON) (interface{}, error)) (sto
 
 	go p.EachEvent(func(e *proto.RuntimeBindingCalled) {
 		if e.Name == bind {
-			payload := gson.NewFrom(e.Payload)
-			res, err := fn(payload.Get("req"))
-			code := fmt.Sprintf("(res, err) => %s(res, err)", payload.Get("cb").Str())
-			_, _ = p.Evaluate(Eval(code, res, err))
+			go func() {
+				payload := gson.NewFrom(e.Payload)
+				res, err := fn(payload.Get("req"))
+				code := fmt.Sprintf("(res, err) => %s(res, err)", payload.Get("cb").Str())
+				// the null error means success, even an error with an empty message will reject
+				var errMsg interface{}
+				if err != nil {
+					errMsg = err.Error()
+				}
+				_, _ = p.Evaluate(Eval(code, res, errMsg))
+			}()
 		}
 	})()
 
@@ -329,6 +374,21 @@ func (p *Page) getJSCtxID() (proto.RuntimeRemoteObjectID, error) {
 		return *p.jsCtxID, nil
 	}
 
+	if p.isolatedWorld != "" {
+		world, err := proto.PageCreateIsolatedWorld{FrameID: p.FrameID, WorldName: p.isolatedWorld}.Call(p)
+		if err != nil {
+			return "", err
+		}
+
+		obj, err := proto.RuntimeEvaluate{Expression: "window", ContextID: world.ExecutionContextID}.Call(p)
+		if err != nil {
+			return "", err
+		}
+
+		*p.jsCtxID = obj.Result.ObjectID
+		return *p.jsCtxID, nil
+	}
+
 	if !p.IsIframe() {
 		obj, err := proto.RuntimeEvaluate{Expression: "window"}.Call(p)
 		if err != nil {
@@ -339,6 +399,7 @@ func (p *Page) getJSCtxID() (proto.RuntimeRemoteObjectID, error) {
 		p.helpersLock.Lock()
 		p.helpers = nil
 		p.helpersLock.Unlock()
+		p.utility.reset()
 		return *p.jsCtxID, nil
 	}
 
@@ -360,6 +421,90 @@ func (p *Page) getJSCtxID() (proto.RuntimeRemoteObjectID, error) {
 	return *p.jsCtxID, err
 }
 
+// Isolated returns a clone that evaluates js in an isolated world with the worldName,
+// the world is created by Page.createIsolatedWorld and recreated after the page navigates.
+// The isolated world shares the DOM with the page, but not the js globals, so the injected
+// js won't collide with the page's globals, and it still works when the page overrides
+// the built-ins like Array or JSON to defeat automation.
+// The elements selected by the js based queries of the clone, such as [Page.Element], also run their js in the isolated world.
+// It also protects the internal js helpers, such as the ones used by [Element.Text],
+// from the pages that tamper with the prototypes, like overriding Element.prototype.matches or window.getComputedStyle.
+// [Element.Matches], [Element.Visible] and [Page.ElementVisible] are always protected, they run in a utility isolated world.
+func (p *Page) Isolated(worldName string) *Page {
+	newObj := *p
+	newObj.isolatedWorld = worldName
+	newObj.jsCtxLock = &sync.Mutex{}
+	newObj.jsCtxID = new(proto.RuntimeRemoteObjectID)
+	return &newObj
+}
+
+// utilityWorldName is the name of the isolated world created for the js helpers of [Page.utilityWorld].
+const utilityWorldName = "rod_utility"
+
+// utilityWorlds is shared by the clones of a page, it maps the js context of each frame to its utility world.
+type utilityWorlds struct {
+	lock   sync.Mutex
+	worlds map[proto.RuntimeRemoteObjectID]*utilityWorld
+}
+
+type utilityWorld struct {
+	page  *Page
+	ctxID proto.RuntimeExecutionContextID
+}
+
+func (u *utilityWorlds) reset() {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+	u.worlds = nil
+}
+
+func (u *utilityWorlds) drop(w *utilityWorld) {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+	for id, v := range u.worlds {
+		if v == w {
+			delete(u.worlds, id)
+		}
+	}
+}
+
+// utilityWorld returns the isolated world for the current js context of the page, it's created on the first use.
+// The page can tamper with the built-ins of its own world, such as Element.prototype.matches,
+// but it can't reach the ones of an isolated world, so the helpers that run in it can't be fooled.
+func (p *Page) utilityWorld() (*utilityWorld, error) {
+	id, err := p.getJSCtxID()
+	if err != nil {
+		return nil, err
+	}
+
+	p.utility.lock.Lock()
+	defer p.utility.lock.Unlock()
+
+	if w, has := p.utility.worlds[id]; has {
+		return w, nil
+	}
+
+	world, err := proto.PageCreateIsolatedWorld{FrameID: p.FrameID, WorldName: utilityWorldName}.Call(p)
+	if err != nil {
+		return nil, err
+	}
+
+	obj, err := proto.RuntimeEvaluate{Expression: "window", ContextID: world.ExecutionContextID}.Call(p)
+	if err != nil {
+		return nil, err
+	}
+
+	page := p.Isolated(utilityWorldName)
+	*page.jsCtxID = obj.Result.ObjectID
+
+	w := &utilityWorld{page: page, ctxID: world.ExecutionContextID}
+	if p.utility.worlds == nil {
+		p.utility.worlds = map[proto.RuntimeRemoteObjectID]*utilityWorld{}
+	}
+	p.utility.worlds[id] = w
+	return w, nil
+}
+
 func (p *Page) unsetJSCtxID() {
 	p.jsCtxLock.Lock()
 	defer p.jsCtxLock.Unlock()
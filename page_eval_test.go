@@ -1,6 +1,7 @@
 package rod_test
 
 import (
+	"errors"
 	"testing"
 	"time"
 
@@ -29,6 +30,60 @@ func TestPageEvalOnNewDocument(t *testing.T) {
 	})
 }
 
+func TestPageEvalOnNewDocumentRemove(t *testing.T) {
+	g := setup(t)
+
+	p := g.newPage()
+
+	remove, err := p.EvalOnNewDocument(`window.rod = 'ok'`)
+	g.E(err)
+
+	// the script runs before the page's own scripts
+	p.MustNavigate(g.html(`<html><script>window.seen = window.rod</script></html>`))
+	g.Eq(p.MustEval("() => seen").String(), "ok")
+
+	g.E(remove())
+
+	p.MustNavigate(g.blank())
+	g.True(p.MustEval("() => window.rod").Nil())
+
+	g.mc.stubErr(1, proto.PageRemoveScriptToEvaluateOnNewDocument{})
+	g.Err(remove())
+}
+
+func TestPageExposeConcurrency(t *testing.T) {
+	g := setup(t)
+
+	page := g.newPage(g.blank()).MustWaitLoad()
+
+	stop := page.MustExpose("exposedFunc", func(j gson.JSON) (interface{}, error) {
+		switch j.Str() {
+		case "slow":
+			utils.Sleep(1)
+		case "err":
+			return nil, errors.New("go error")
+		case "empty-err":
+			return nil, errors.New("")
+		}
+		return j.Str(), nil
+	})
+	defer stop()
+
+	res := page.MustEval(`async () => {
+		const list = []
+		await Promise.all([
+			exposedFunc('slow').then(r => list.push(r)),
+			exposedFunc('fast').then(r => list.push(r)),
+		])
+		return list
+	}`)
+	g.Eq(res.Arr()[0].Str(), "fast")
+	g.Eq(res.Arr()[1].Str(), "slow")
+
+	g.Eq(page.MustEval(`() => exposedFunc('err').catch(e => e)`).Str(), "go error")
+	g.Eq(page.MustEval(`() => exposedFunc('empty-err').then(() => 'resolved', e => 'rejected: ' + e)`).Str(), "rejected: ")
+}
+
 func TestPageEval(t *testing.T) {
 	g := setup(t)
 
@@ -58,6 +113,150 @@ func TestPageEval(t *testing.T) {
 	g.Has(err.Error(), `eval js error: ReferenceError: notExist is not defined`)
 }
 
+func TestPageEvalPromise(t *testing.T) {
+	g := setup(t)
+
+	s := g.Serve()
+	s.Route("/data", ".json", `{"a": 1}`)
+
+	page := g.page.MustNavigate(s.URL("/data"))
+
+	g.Eq(1, page.MustEval(`async () => (await (await fetch(location.href)).json()).a`).Int())
+	g.Eq("ok", page.MustEval(`() => new Promise(r => setTimeout(() => r('ok'), 10))`).Str())
+
+	_, err := page.Eval(`async function foo() { throw new Error('err') }`)
+	g.Is(err, &rod.EvalError{})
+	g.Has(err.Error(), "eval js error: Error: err\n    at foo")
+
+	g.Eq((&rod.EvalError{RuntimeExceptionDetails: &proto.RuntimeExceptionDetails{Text: "Uncaught"}}).Error(), "eval js error: Uncaught")
+}
+
+func TestPageEvalByObject(t *testing.T) {
+	g := setup(t)
+
+	page := g.page.MustNavigate(g.blank())
+
+	list := page.MustEvaluate(rod.Eval(`() => Array(10000).fill(1)`).ByObject())
+	g.Eq(list.Subtype, proto.RuntimeRemoteObjectSubtypeArray)
+	g.Nil(list.Value.Val())
+
+	sum := page.MustEvaluate(rod.Eval(`(l) => ({ n: l.reduce((a, b) => a + b) })`, list).ByObject())
+	g.Eq(10000, page.MustEval(`s => s.n`, sum).Int())
+	g.Eq(10000, page.MustEvaluate(rod.Eval(`function() { return this.length }`).This(list)).Value.Int())
+
+	page.MustRelease(list)
+	_, err := page.Eval(`l => l.length`, list)
+	g.Err(err)
+}
+
+func TestPageEvalTo(t *testing.T) {
+	g := setup(t)
+
+	page := g.page.MustNavigate(g.blank())
+
+	var data struct {
+		A int
+		B []string
+	}
+	page.MustEvalTo(&data, `() => ({ A: 1, B: ['x', 'y'] })`)
+	g.Eq(data.A, 1)
+	g.Eq(data.B, []string{"x", "y"})
+
+	var m map[string]int
+	g.E(page.EvalTo(&m, `async (k) => ({ [k]: 2 })`, "c"))
+	g.Eq(m, map[string]int{"c": 2})
+
+	g.Eq(page.EvalTo(&m, `() => {}`).Error(), "eval js returns undefined, can't unmarshal it")
+	g.Has(page.EvalTo(&m, `() => 'str'`).Error(), "failed to unmarshal the eval result")
+	g.Is(page.EvalTo(&m, `() => notExist()`), &rod.EvalError{})
+}
+
+func TestPageIsolated(t *testing.T) {
+	g := setup(t)
+
+	page := g.page.MustNavigate(g.html(`<button onclick="this.innerText = 'clicked'">btn</button>
+		<script>window.secret = 1; JSON.stringify = () => 'hacked'; Element.prototype.matches = () => false</script>`))
+
+	g.Eq(page.MustEval(`() => JSON.stringify([1])`).Str(), "hacked")
+
+	iso := page.Isolated("rod")
+	g.Nil(iso.MustEval(`() => window.secret`).Val())
+	g.Eq(iso.MustEval(`() => JSON.stringify([1])`).Str(), "[1]")
+	iso.MustEval(`() => window.mine = 1`)
+	g.Nil(page.MustEval(`() => window.mine`).Val())
+
+	btn := iso.MustElement("button")
+	g.True(btn.MustMatches("button"))
+	btn.MustClick()
+	g.Eq(page.MustElement("button").MustText(), "clicked")
+
+	// the world is recreated after navigation
+	page.MustNavigate(g.blank())
+	g.Eq(iso.MustEval(`() => JSON.stringify([2])`).Str(), "[2]")
+
+	g.mc.stubErr(1, proto.PageCreateIsolatedWorld{})
+	g.Err(page.Isolated("rod").Eval(`() => 1`))
+
+	g.mc.stubErr(1, proto.RuntimeEvaluate{})
+	g.Err(page.Isolated("rod").Eval(`() => 1`))
+}
+
+func TestPageIsolatedHelpers(t *testing.T) {
+	g := setup(t)
+
+	page := g.page.MustNavigate(g.html(`<p>text</p><div style="display: none">hidden</div>
+		<script>
+			window.__rodNatives = { apply: () => true, matches: () => true }
+			Element.prototype.matches = () => false
+			Element.prototype.getBoundingClientRect = () => ({ width: 1, height: 1 })
+			window.getComputedStyle = () => ({ display: 'block', visibility: 'visible' })
+		</script>`))
+
+	// the tampered built-ins fool the page's world, but not the helpers that run in the utility world
+	g.True(page.MustEval(`() => !document.body.matches('body')`).Bool())
+	g.True(page.MustElement("p").MustMatches("p"))
+	g.False(page.MustElement("p").MustMatches("div"))
+	g.True(page.MustElement("p").MustVisible())
+	g.False(page.MustElement("div").MustVisible())
+	page.MustElement("p").MustWaitVisible()
+	page.MustElement("div").MustWaitInvisible()
+	g.Eq(page.MustElementVisible("p, div").MustText(), "text")
+	g.Eq(page.MustElementVisible("p, div").MustEval(`() => this.tagName`).Str(), "P")
+
+	p := page.MustElement("p")
+
+	// a destroyed utility world is recreated
+	g.mc.stub(1, proto.DOMResolveNode{}, func(_ StubSend) (gson.JSON, error) {
+		return gson.New(nil), cdp.ErrCtxNotFound
+	})
+	g.True(p.MustVisible())
+
+	g.mc.stubErr(1, proto.DOMResolveNode{})
+	g.Err(p.Visible())
+
+	g.mc.stubErr(1, proto.DOMDescribeNode{})
+	g.Err(p.Matches("p"))
+
+	page.MustReload()
+	p = page.MustElement("p")
+
+	g.mc.stubErr(1, proto.PageCreateIsolatedWorld{})
+	g.Err(p.Visible())
+
+	g.mc.stubErr(1, proto.RuntimeEvaluate{})
+	g.Err(p.Visible())
+
+	page.MustReload()
+	g.mc.stubErr(1, proto.PageCreateIsolatedWorld{})
+	g.Err(page.ElementVisible("p"))
+
+	iso := page.Isolated("rod")
+	g.True(iso.MustElement("p").MustMatches("p"))
+	g.Eq(iso.MustElement("p").MustText(), "text")
+	g.False(iso.MustElement("div").MustVisible())
+	g.Eq(iso.MustElementVisible("p, div").MustText(), "text")
+}
+
 func TestPageEvaluateRetry(t *testing.T) {
 	g := setup(t)
 
@@ -3,15 +3,18 @@ package rod_test
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"image/png"
 	"math"
 	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"runtime"
 	"sort"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -77,6 +80,45 @@ func TestSetCookies(t *testing.T) {
 	})
 }
 
+func TestClearCookies(t *testing.T) {
+	g := setup(t)
+
+	s := g.Serve()
+	other := "http://example.com"
+
+	page := g.newPage().MustSetCookies([]*proto.NetworkCookieParam{{
+		Name:     "a",
+		Value:    "1",
+		URL:      s.URL(),
+		Expires:  proto.TimeSinceEpoch(time.Now().Add(time.Hour).Unix()),
+		SameSite: proto.NetworkCookieSameSiteLax,
+	}, {
+		Name:  "b",
+		Value: "2",
+		URL:   other,
+	}}...).MustNavigate(s.URL()).MustWaitLoad()
+
+	g.Len(page.MustCookies(), 1)
+	g.Eq(page.MustCookies()[0].SameSite, proto.NetworkCookieSameSiteLax)
+
+	page.MustClearCookies()
+	g.Len(page.MustCookies(), 0)
+	g.Len(page.MustCookies(other), 1)
+
+	page.MustSetCookies()
+
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.NetworkGetCookies{})
+		page.MustClearCookies()
+	})
+
+	page.MustSetCookies(&proto.NetworkCookieParam{Name: "a", Value: "1", URL: s.URL()})
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.NetworkDeleteCookies{})
+		page.MustClearCookies()
+	})
+}
+
 func TestSetBlockedURLs(t *testing.T) {
 	g := setup(t)
 	page := g.newPage()
@@ -93,6 +135,27 @@ func TestSetBlockedURLs(t *testing.T) {
 	page.MustNavigate("https://github.com")
 }
 
+func TestPageIgnoreCertErrors(t *testing.T) {
+	g := setup(t)
+
+	s := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`<html><body>ok</body></html>`))
+	}))
+	defer s.Close()
+
+	p := g.newPage()
+	g.Err(p.Navigate(s.URL))
+
+	p.MustIgnoreCertErrors(true)
+	p.MustNavigate(s.URL)
+	g.Eq(p.MustElement("body").MustText(), "ok")
+
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.SecuritySetIgnoreCertificateErrors{})
+		p.MustIgnoreCertErrors(false)
+	})
+}
+
 func TestSetExtraHeaders(t *testing.T) {
 	g := setup(t)
 
@@ -125,6 +188,38 @@ func TestSetExtraHeaders(t *testing.T) {
 	g.Eq(header.Get("b"), "")
 }
 
+func TestSetExtraHeadersSubResources(t *testing.T) {
+	g := setup(t)
+
+	s := g.Serve()
+
+	wg := sync.WaitGroup{}
+	headers := sync.Map{}
+	record := func(name string) {
+		s.Mux.HandleFunc("/"+name, func(w http.ResponseWriter, r *http.Request) {
+			headers.Store(name, r.Header.Get("X-Test"))
+			wg.Done()
+			g.HandleHTTP(".html", `<html></html>`)(w, r)
+		})
+	}
+	record("img")
+	record("iframe")
+	s.Route("/page", ".html", `<html><img src="/img"><iframe src="/iframe"></iframe></html>`)
+
+	p := g.newPage()
+	cleanup := p.MustSetExtraHeaders("X-Test", "1")
+	defer cleanup()
+
+	wg.Add(2)
+	p.MustNavigate(s.URL("/page"))
+	wg.Wait()
+
+	for _, name := range []string{"img", "iframe"} {
+		v, _ := headers.Load(name)
+		g.Eq(v, "1")
+	}
+}
+
 func TestSetUserAgent(t *testing.T) {
 	g := setup(t)
 
@@ -149,6 +244,30 @@ func TestSetUserAgent(t *testing.T) {
 	g.Eq(lang, "en")
 }
 
+func TestSetUserAgentString(t *testing.T) {
+	g := setup(t)
+
+	s := g.Serve()
+
+	ua := ""
+	lang := ""
+
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+
+	s.Mux.HandleFunc("/", func(_ http.ResponseWriter, r *http.Request) {
+		ua = r.Header.Get("User-Agent")
+		lang = r.Header.Get("Accept-Language")
+		wg.Done()
+	})
+
+	g.newPage().MustSetUserAgentString("test-agent", "fr-FR").MustNavigate(s.URL())
+	wg.Wait()
+
+	g.Eq(ua, "test-agent")
+	g.Has(lang, "fr-FR")
+}
+
 func TestPageHTML(t *testing.T) {
 	g := setup(t)
 
@@ -156,6 +275,9 @@ func TestPageHTML(t *testing.T) {
 	p.MustElement("button").MustClick()
 	g.Has(p.MustHTML(), `a="ok"`)
 
+	p = g.page.MustNavigate(g.html(`<p>a</p><script>document.querySelector('p').innerText = 'b'</script>`))
+	g.Regex(`\A<html><head></head><body><p>b</p><script>.+</script></body></html>\z`, p.MustHTML())
+
 	g.mc.stubErr(1, proto.RuntimeCallFunctionOn{})
 	g.Err(p.HTML())
 }
@@ -201,12 +323,75 @@ func TestPageContext(t *testing.T) {
 
 	g.page.Timeout(time.Hour).CancelTimeout().MustEval(`() => 1`)
 	_, _ = g.page.Timeout(time.Second).Timeout(time.Hour).CancelTimeout().Element("not-exist")
+
+	g.Eq(g.page.CancelTimeout(), g.page)
+	g.Eq(g.browser.CancelTimeout(), g.browser)
+
+	el := g.page.MustNavigate(g.blank()).MustElement("body")
+	g.Eq(el.CancelTimeout(), el)
+	_, err := el.Timeout(100 * time.Millisecond).Element("not-exist")
+	g.Is(err, context.DeadlineExceeded)
+}
+
+func TestPageAutoRelease(t *testing.T) {
+	g := setup(t)
+
+	released := []proto.RuntimeRemoteObjectID{}
+	g.mc.setCall(func(ctx context.Context, sessionID, method string, params interface{}) ([]byte, error) {
+		if method == (proto.RuntimeReleaseObject{}).ProtoReq() {
+			var req proto.RuntimeReleaseObject
+			g.E(json.Unmarshal(utils.MustToJSONBytes(params), &req))
+			released = append(released, req.ObjectID)
+		}
+		return g.mc.principal.Call(ctx, sessionID, method, params)
+	})
+	defer g.mc.resetCall()
+
+	page := g.page.MustNavigate(g.html(`<p>a</p><p>b</p>`))
+	p := page.AutoRelease(true)
+
+	untracked := page.MustElement("p")
+	a := p.MustElement("html")
+	b := p.MustElement("body")
+	list := p.MustElements("p")
+
+	// the explicitly released element won't be released again
+	g.E(b.Release())
+	released = nil
+
+	g.E(p.ReleaseAll())
+	g.Len(released, 1+len(list))
+	g.Has(released, a.Object.ObjectID)
+	g.Has(released, list[0].Object.ObjectID)
+	g.Eq(untracked.MustText(), "a")
+
+	// nothing is left to release
+	released = nil
+	g.E(page.ReleaseAll())
+	g.Len(released, 0)
+
+	// the tracked elements are released before the navigation
+	c := p.MustElement("p")
+	p.MustNavigate(g.blank())
+	g.Eq(released, []proto.RuntimeRemoteObjectID{c.Object.ObjectID})
+
+	// the objects that are already gone are skipped
+	p.MustNavigate(g.html(`<p>a</p>`))
+	p.MustElement("p")
+	p.MustReload()
+	g.E(p.ReleaseAll())
 }
 
 func TestPageActivate(t *testing.T) {
 	g := setup(t)
 
 	g.page.MustActivate()
+
+	p := g.newPage(g.blank())
+	g.Eq(p.MustActivate(), p)
+
+	g.mc.stubErr(1, proto.TargetActivateTarget{})
+	g.Err(p.Activate())
 }
 
 func TestWindow(t *testing.T) {
@@ -265,6 +450,142 @@ func TestSetViewport(t *testing.T) {
 	g.Neq(int(317), res.Get("0").Int())
 }
 
+func TestClearViewport(t *testing.T) {
+	g := setup(t)
+
+	page := g.newPage(g.blank())
+	page.MustSetViewport(317, 419, 1, false)
+
+	img, err := png.Decode(bytes.NewBuffer(page.MustScreenshot()))
+	g.E(err)
+	g.Eq(317, img.Bounds().Dx())
+	g.Eq(419, img.Bounds().Dy())
+
+	page.MustClearViewport()
+	g.Neq(317, page.MustEval(`() => window.innerWidth`).Int())
+
+	g.mc.stubErr(1, proto.EmulationClearDeviceMetricsOverride{})
+	g.Err(page.ClearViewport())
+}
+
+func TestEmulateGeolocation(t *testing.T) {
+	g := setup(t)
+
+	page := g.newPage(g.blank())
+	page.MustEmulateGeolocation(31.23, 121.47, 10)
+
+	res := page.MustEval(`() => new Promise((resolve, reject) =>
+		navigator.geolocation.getCurrentPosition(
+			(p) => resolve([p.coords.latitude, p.coords.longitude, p.coords.accuracy]),
+			(e) => reject(e.message),
+		)
+	)`)
+	g.Eq(31.23, res.Get("0").Num())
+	g.Eq(121.47, res.Get("1").Num())
+	g.Eq(10.0, res.Get("2").Num())
+
+	page.MustClearGeolocation()
+
+	g.mc.stubErr(1, proto.BrowserGrantPermissions{})
+	g.Err(page.EmulateGeolocation(0, 0, 0))
+
+	g.mc.stubErr(1, proto.EmulationSetGeolocationOverride{})
+	g.Err(page.EmulateGeolocation(0, 0, 0))
+
+	g.mc.stubErr(1, proto.EmulationClearGeolocationOverride{})
+	g.Err(page.ClearGeolocation())
+}
+
+func TestEmulateTimezoneAndLocale(t *testing.T) {
+	g := setup(t)
+
+	page := g.newPage(g.blank())
+	page.MustEmulateTimezone("Asia/Tokyo").MustEmulateLocale("fr_FR")
+
+	g.Eq("Asia/Tokyo", page.MustEval(`() => Intl.DateTimeFormat().resolvedOptions().timeZone`).Str())
+	g.Eq(-540, page.MustEval(`() => new Date(0).getTimezoneOffset()`).Int())
+	g.Eq("fr-FR", page.MustEval(`() => Intl.DateTimeFormat().resolvedOptions().locale`).Str())
+
+	g.Err(page.EmulateTimezone("Nowhere/Nothing"))
+
+	page.MustEmulateTimezone("").MustEmulateLocale("")
+
+	g.mc.stubErr(1, proto.EmulationSetLocaleOverride{})
+	g.Err(page.EmulateLocale("en_US"))
+}
+
+func TestEmulateMedia(t *testing.T) {
+	g := setup(t)
+
+	page := g.newPage(g.blank())
+	matches := func(query string) bool {
+		return page.MustEval(`q => matchMedia(q).matches`, query).Bool()
+	}
+
+	page.MustEmulateDarkMode(true)
+	g.True(matches("(prefers-color-scheme: dark)"))
+
+	page.MustEmulateDarkMode(false)
+	g.True(matches("(prefers-color-scheme: light)"))
+
+	page.MustEmulateMediaFeatures([]*proto.EmulationMediaFeature{{Name: "prefers-reduced-motion", Value: "reduce"}})
+	g.True(matches("(prefers-reduced-motion: reduce)"))
+
+	page.MustEmulateMedia("print")
+	g.True(matches("print"))
+
+	page.MustEmulateMedia("").MustEmulateMediaFeatures(nil)
+	g.True(matches("screen"))
+
+	g.mc.stubErr(1, proto.EmulationSetEmulatedMedia{})
+	g.Err(page.EmulateDarkMode(true))
+}
+
+func TestEmulateThrottling(t *testing.T) {
+	g := setup(t)
+
+	page := g.newPage(g.blank())
+
+	page.MustEmulateCPUThrottling(4).MustEmulateCPUThrottling(1)
+
+	var params proto.NetworkEmulateNetworkConditions
+	g.mc.setCall(func(ctx context.Context, sessionID, method string, p interface{}) ([]byte, error) {
+		if method == params.ProtoReq() {
+			g.E(json.Unmarshal(utils.MustToJSONBytes(p), &params))
+		}
+		return g.mc.principal.Call(ctx, sessionID, method, p)
+	})
+	page.MustEmulateNetworkConditions(false, 100, 800, -1)
+	g.mc.resetCall()
+	g.Eq(100.0, params.Latency)
+	g.Eq(100000.0, params.DownloadThroughput)
+	g.Eq(-1.0, params.UploadThroughput)
+
+	page.MustOffline(true)
+	g.False(page.MustEval(`() => navigator.onLine`).Bool())
+
+	// the Network domain is restored only after the conditions are reset
+	disabled := 0
+	g.mc.setCall(func(ctx context.Context, sessionID, method string, p interface{}) ([]byte, error) {
+		if method == "Network.disable" {
+			disabled++
+		}
+		return g.mc.principal.Call(ctx, sessionID, method, p)
+	})
+	page.MustOffline(true)
+	g.Eq(disabled, 0)
+	page.MustOffline(false)
+	g.mc.resetCall()
+	g.Eq(disabled, 1)
+	g.True(page.MustEval(`() => navigator.onLine`).Bool())
+
+	g.mc.stubErr(1, proto.EmulationSetCPUThrottlingRate{})
+	g.Err(page.EmulateCPUThrottling(2))
+
+	g.mc.stubErr(1, proto.NetworkEmulateNetworkConditions{})
+	g.Err(page.Offline(true))
+}
+
 func TestSetDocumentContent(t *testing.T) {
 	g := setup(t)
 
@@ -347,6 +668,31 @@ func TestEmulateDevice(t *testing.T) {
 	})
 }
 
+func TestEmulateCustomDevice(t *testing.T) {
+	g := setup(t)
+
+	page := g.newPage(g.blank())
+	page.MustEmulate(devices.Device{
+		Title:          "custom",
+		Capabilities:   []string{"touch", "mobile"},
+		UserAgent:      "custom-agent",
+		AcceptLanguage: "en",
+		Screen: devices.Screen{
+			DevicePixelRatio: 2,
+			Horizontal:       devices.ScreenSize{Width: 600, Height: 400},
+			Vertical:         devices.ScreenSize{Width: 400, Height: 600},
+		},
+	})
+	page.MustNavigate(g.blank())
+
+	res := page.MustEval(`() => [devicePixelRatio, navigator.userAgent, navigator.maxTouchPoints > 0]`)
+	g.Eq(res.Get("0").Num(), 2.0)
+	g.Eq(res.Get("1").Str(), "custom-agent")
+	g.True(res.Get("2").Bool())
+
+	page.MustEmulate(devices.Clear)
+}
+
 func TestPageCloseErr(t *testing.T) {
 	g := setup(t)
 
@@ -357,6 +703,14 @@ func TestPageCloseErr(t *testing.T) {
 	})
 }
 
+func TestPageCloseTwice(t *testing.T) {
+	g := setup(t)
+
+	p := g.browser.MustPage(g.blank())
+	p.MustClose()
+	g.Err(p.Close())
+}
+
 func TestPageCloseWhenNotAttached(t *testing.T) {
 	g := setup(t)
 
@@ -444,6 +798,25 @@ func TestPageWaitNavigation(t *testing.T) {
 	wait()
 }
 
+func TestPageWaitNavigationClick(t *testing.T) {
+	g := setup(t)
+
+	s := g.Serve()
+	s.Route("/next", ".html", `<html><body>next</body></html>`)
+	s.Route("/", ".html", `<html><body>
+		<iframe src="/next"></iframe>
+		<a href="/next">link</a>
+	</body></html>`)
+
+	page := g.newPage(s.URL()).MustWaitLoad()
+
+	wait := page.WaitNavigation(proto.PageLifecycleEventNameDOMContentLoaded)
+	page.MustElement("a").MustClick()
+	wait()
+
+	g.Eq(s.URL("/next"), page.MustInfo().URL)
+}
+
 func TestPageWaitRequestIdle(t *testing.T) {
 	g := setup(t)
 
@@ -593,6 +966,16 @@ func TestPageWaitStable(t *testing.T) {
 	})
 }
 
+func TestPageWaitStableLiveClock(t *testing.T) {
+	g := setup(t)
+
+	p := g.page.MustNavigate(g.html(`<ul>` + strings.Repeat(`<li>item</li>`, 100) + `</ul><p id="clock"></p>
+		<script>setInterval(() => clock.innerText = Date.now(), 100)</script>`))
+
+	g.Is(p.Timeout(2*time.Second).WaitStable(300*time.Millisecond), context.DeadlineExceeded)
+	g.E(p.Timeout(5*time.Second).WaitDOMStable(300*time.Millisecond, 0.1))
+}
+
 func TestPageWaitIdle(t *testing.T) {
 	g := setup(t)
 
@@ -625,6 +1008,16 @@ func TestPageWaitEvent(t *testing.T) {
 	wait()
 }
 
+func TestPageWaitEventTimeout(t *testing.T) {
+	g := setup(t)
+
+	p := g.page.Timeout(100 * time.Millisecond)
+	e := &proto.PageJavascriptDialogOpening{}
+	p.WaitEvent(e)()
+	g.Eq("", e.Message)
+	g.Is(p.GetContext().Err(), context.DeadlineExceeded)
+}
+
 func TestPageWaitEventParseEventOnlyOnce(t *testing.T) {
 	g := setup(t)
 
@@ -685,6 +1078,132 @@ func TestAlert(t *testing.T) {
 	handle(true, "")
 }
 
+func TestHandleNextDialog(t *testing.T) {
+	g := setup(t)
+
+	page := g.page.MustNavigate(g.blank())
+
+	wait := page.MustHandleNextDialog(true, "rod")
+	g.Eq("rod", page.MustEval(`() => prompt("name?")`).Str())
+	wait()
+
+	wait = page.MustHandleNextDialog(false, "")
+	g.False(page.MustEval(`() => confirm("ok?")`).Bool())
+	wait()
+
+	g.mc.stubErr(1, proto.PageHandleJavaScriptDialog{})
+	w := page.HandleNextDialog(true, "")
+	page.MustEval(`() => setTimeout(() => alert("x"))`)
+	g.Err(w())
+	g.E(proto.PageHandleJavaScriptDialog{Accept: true}.Call(page))
+
+	// no dialog opens before the timeout
+	w = page.Timeout(100*time.Millisecond).HandleNextDialog(true, "")
+	g.Is(w(), context.DeadlineExceeded)
+}
+
+func TestPageDownloadFile(t *testing.T) {
+	g := setup(t)
+
+	s := g.Serve()
+	s.Mux.HandleFunc("/export", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Disposition", `attachment; filename="report.csv"`)
+		g.E(w.Write([]byte("a,b\n1,2")))
+	})
+	s.Route("/", ".html", `<html><a href="/export">export</a></html>`)
+
+	page := g.newPage(s.URL())
+
+	data, name := page.MustDownloadFile(func() {
+		page.MustElement("a").MustClick()
+	})
+	g.Eq("a,b\n1,2", string(data))
+	g.Eq("report.csv", name)
+
+	_, _, err := page.DownloadFile(func() error { return errors.New("trigger") })
+	g.Eq("trigger", err.Error())
+
+	_, _, err = page.Timeout(100 * time.Millisecond).DownloadFile(func() error { return nil })
+	g.Is(err, context.DeadlineExceeded)
+}
+
+func TestPageHandleFileDialogProgrammatic(t *testing.T) {
+	g := setup(t)
+
+	p := g.page.MustNavigate(g.html(`<html>
+		<div id="zone" onclick="pick()">drop here</div>
+		<script>
+			window.names = []
+			function pick() {
+				const input = document.createElement('input')
+				input.type = 'file'
+				input.multiple = true
+				input.onchange = () => { window.names = Array.from(input.files).map(f => f.name) }
+				input.click()
+			}
+		</script>
+	</html>`))
+
+	setFiles := p.MustHandleFileDialog()
+	p.MustElement("#zone").MustClick()
+	setFiles(slash("fixtures/click.html"))
+
+	p.MustWait(`() => window.names.length === 1`)
+	g.Eq("click.html", p.MustEval(`() => window.names[0]`).Str())
+}
+
+func TestPageGetMetrics(t *testing.T) {
+	g := setup(t)
+
+	p := g.newPage(g.srcFile("fixtures/click.html")).MustWaitLoad()
+
+	disabled := false
+	g.mc.setCall(func(ctx context.Context, sessionID, method string, params interface{}) ([]byte, error) {
+		if method == "Performance.disable" {
+			disabled = true
+		}
+		return g.mc.principal.Call(ctx, sessionID, method, params)
+	})
+	m := p.MustGetMetrics()
+	g.mc.resetCall()
+	g.Gt(m["JSHeapUsedSize"], 0.0)
+	_, has := m["LayoutCount"]
+	g.True(has)
+	g.True(disabled)
+
+	g.mc.stubErr(1, proto.PerformanceGetMetrics{})
+	g.Err(p.GetMetrics())
+}
+
+func TestPageTracing(t *testing.T) {
+	g := setup(t)
+
+	p := g.newPage()
+
+	p.MustStartTracing("devtools.timeline")
+	p.MustNavigate(g.srcFile("fixtures/click.html")).MustWaitLoad()
+	trace := p.MustStopTracing()
+
+	var data struct {
+		TraceEvents []struct {
+			Cat string `json:"cat"`
+		} `json:"traceEvents"`
+	}
+	g.E(json.Unmarshal(trace, &data))
+	g.Gt(len(data.TraceEvents), 0)
+
+	g.mc.stubErr(1, proto.TracingStart{})
+	g.Err(p.StartTracing(nil))
+
+	g.mc.stubErr(1, proto.TracingEnd{})
+	g.Err(p.StopTracing())
+
+	p.MustStartTracing()
+	_, err := p.Timeout(0).StopTracing()
+	g.Err(err)
+	p.MustStopTracing()
+}
+
 func TestPageHandleFileDialog(t *testing.T) {
 	g := setup(t)
 
@@ -774,6 +1293,59 @@ func TestScreenshotFullPage(t *testing.T) {
 	})
 }
 
+func TestPageScreenshotFullPage(t *testing.T) {
+	g := setup(t)
+
+	p := g.page.MustNavigate(g.srcFile("fixtures/scroll.html"))
+	p.MustElement("button")
+
+	res := p.MustEval(`() => ({w: document.documentElement.scrollWidth, h: document.documentElement.scrollHeight})`)
+
+	for _, scroll := range []bool{false, true} {
+		data, err := p.ScreenshotFullPage(proto.PageCaptureScreenshotFormatPng, 0, scroll)
+		g.E(err)
+		img, err := png.Decode(bytes.NewBuffer(data))
+		g.E(err)
+		g.Eq(res.Get("w").Int(), img.Bounds().Dx())
+		g.Eq(res.Get("h").Int(), img.Bounds().Dy())
+	}
+
+	g.Eq(p.MustEval(`() => scrollY`).Int(), 0)
+
+	g.mc.stubErr(1, proto.RuntimeCallFunctionOn{})
+	g.Err(p.ScreenshotFullPage(proto.PageCaptureScreenshotFormatPng, 0, true))
+}
+
+func TestScreenshotClipSelector(t *testing.T) {
+	g := setup(t)
+
+	p := g.page.MustNavigate(g.html(`<html><body>
+		<div class="a" style="display: none; width: 10px; height: 10px"></div>
+		<div class="a" style="width: 30px; height: 20px; background: red"></div>
+	</body></html>`))
+
+	img, err := png.Decode(bytes.NewBuffer(p.MustScreenshotClipSelector(".a")))
+	g.E(err)
+	g.Eq(30, img.Bounds().Dx())
+	g.Eq(20, img.Bounds().Dy())
+
+	_, err = p.Sleeper(rod.NotFoundSleeper).ScreenshotClipSelector(".not-exists", proto.PageCaptureScreenshotFormatPng, 0)
+	g.Is(err, &rod.ElementNotFoundError{})
+
+	// only the hidden matches
+	_, err = p.Sleeper(rod.NotFoundSleeper).ScreenshotClipSelector("div[style*=none]", proto.PageCaptureScreenshotFormatPng, 0)
+	g.Is(err, &rod.ElementNotFoundError{})
+
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.RuntimeCallFunctionOn{})
+		p.MustScreenshotClipSelector(".a")
+	})
+	g.Panic(func() {
+		g.mc.stubErr(2, proto.RuntimeCallFunctionOn{})
+		p.MustScreenshotClipSelector(".a")
+	})
+}
+
 func TestScrollScreenshot(t *testing.T) {
 	g := setup(t)
 
@@ -886,6 +1458,33 @@ func TestFonts(t *testing.T) {
 	p.MustPDF("tmp", "fonts.pdf") // download the file from Github Actions Artifacts
 }
 
+func TestPageResourceTree(t *testing.T) {
+	g := setup(t)
+
+	p := g.page.MustNavigate(g.srcFile("fixtures/resource.html"))
+	p.MustElement("img").MustWaitLoad()
+
+	tree := p.MustResourceTree()
+	g.Eq(tree.Frame.ID, p.FrameID)
+
+	var img string
+	for _, r := range tree.Resources {
+		if r.Type == proto.NetworkResourceTypeImage {
+			img = r.URL
+		}
+	}
+	g.Has(img, "icon.png")
+
+	bin, err := p.GetResource(img)
+	g.E(err)
+	g.Eq(len(bin), 22661)
+
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.PageGetResourceTree{})
+		p.MustResourceTree()
+	})
+}
+
 func TestPagePDF(t *testing.T) {
 	g := setup(t)
 
@@ -897,10 +1496,31 @@ func TestPagePDF(t *testing.T) {
 
 	p.MustPDF("")
 
+	var req proto.PagePrintToPDF
+	g.mc.setCall(func(ctx context.Context, sessionID, method string, params interface{}) ([]byte, error) {
+		if method == (proto.PagePrintToPDF{}).ProtoReq() {
+			g.E(json.Unmarshal(utils.MustToJSONBytes(params), &req))
+		}
+		return g.mc.principal.Call(ctx, sessionID, method, params)
+	})
+	s, err = p.PDF(nil)
+	g.mc.resetCall()
+	g.E(err)
+	g.Nil(s.Close())
+	g.True(req.PrintBackground)
+
 	g.Panic(func() {
 		g.mc.stubErr(1, proto.PagePrintToPDF{})
 		p.MustPDF()
 	})
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.IORead{})
+		p.MustPDF()
+	})
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.IOClose{})
+		p.MustPDF()
+	})
 }
 
 func TestPageNavigateNetworkErr(t *testing.T) {
@@ -973,6 +1593,38 @@ func TestPageNavigation(t *testing.T) {
 	g.Err(p.Reload())
 }
 
+func TestPageNavigationHistoryEnds(t *testing.T) {
+	g := setup(t)
+
+	p := g.newPage(g.srcFile("fixtures/click.html")).MustWaitLoad()
+	p.MustResetNavigationHistory()
+
+	p.MustNavigateBack().MustNavigateForward()
+	g.Regex("fixtures/click.html$", p.MustInfo().URL)
+}
+
+func TestPageReloadIgnoreCache(t *testing.T) {
+	g := setup(t)
+
+	s := g.Serve()
+	cacheControl := make(chan string, 2)
+	s.Mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		cacheControl <- r.Header.Get("Cache-Control")
+		w.Header().Set("Cache-Control", "max-age=3600")
+		g.E(w.Write([]byte("<html>ok</html>")))
+	})
+
+	p := g.newPage(s.URL())
+	<-cacheControl
+
+	p.MustReloadIgnoreCache()
+	g.Eq("no-cache", <-cacheControl)
+	g.Eq("ok", p.MustElement("html").MustText())
+
+	g.mc.stubErr(1, proto.PageReload{})
+	g.Err(p.ReloadIgnoreCache())
+}
+
 func TestPagePool(t *testing.T) {
 	g := setup(t)
 
@@ -5,6 +5,7 @@ package rod
 import (
 	"errors"
 	"regexp"
+	"strings"
 
 	"github.com/yontaruron/rod/lib/cdp"
 	"github.com/yontaruron/rod/lib/js"
@@ -22,6 +23,10 @@ const (
 	SelectorTypeCSSSector SelectorType = "css-selector"
 	// SelectorTypeText type.
 	SelectorTypeText SelectorType = "text"
+	// SelectorTypeValue type, matches the value of the option.
+	SelectorTypeValue SelectorType = "value"
+	// SelectorTypeIndex type, matches the index of the option, such as "0" for the first option.
+	SelectorTypeIndex SelectorType = "index"
 )
 
 // Elements provides some helpers to deal with element list.
@@ -48,6 +53,42 @@ func (els Elements) Empty() bool {
 	return len(els) == 0
 }
 
+// Each calls fn for each element in order, it stops on the first error and returns it.
+func (els Elements) Each(fn func(i int, el *Element) error) error {
+	for i, el := range els {
+		err := fn(i, el)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Filter returns the elements that the js function returns a truthy value for,
+// the "this" of the js function is the element, such as:
+//
+//	list.Filter(`() => this.checked`)
+func (els Elements) Filter(js string, params ...interface{}) (Elements, error) {
+	list := Elements{}
+	for _, el := range els {
+		res, err := el.Eval(`function() { return !!(`+strings.Trim(js, "\t\n\v\f\r ;")+`).apply(this, arguments) }`, params...)
+		if err != nil {
+			return nil, err
+		}
+		if res.Value.Bool() {
+			list = append(list, el)
+		}
+	}
+	return list, nil
+}
+
+// Release all the elements, it stops on the first error and returns it.
+func (els Elements) Release() error {
+	return els.Each(func(_ int, el *Element) error {
+		return el.Release()
+	})
+}
+
 // Pages provides some helpers to deal with page list.
 type Pages []*Page
 
@@ -102,6 +143,9 @@ func (ps Pages) FindByURL(jsRegex string) (*Page, error) {
 }
 
 // Has an element that matches the css selector.
+// Unlike [Page.Element], it won't wait for the element to appear, it checks only once and
+// returns false without an error if not found, or true and the element if found.
+// Other errors, such as a broken connection, will still be returned.
 func (p *Page) Has(selector string) (bool, *Element, error) {
 	el, err := p.Sleeper(NotFoundSleeper).Element(selector)
 	if errors.Is(err, &ElementNotFoundError{}) {
@@ -149,8 +193,35 @@ func (p *Page) ElementR(selector, jsRegex string) (*Element, error) {
 	return p.ElementByJS(evalHelper(js.ElementR, selector, jsRegex))
 }
 
+// ElementVisible retries until a visible element in the page that matches the css selector,
+// then returns the matched element. Unlike [Page.Element], the hidden matches are skipped,
+// such as the ones with "display: none".
+// The query runs in the utility world of the page, so the page can't fool it by tampering with the built-ins.
+func (p *Page) ElementVisible(selector string) (*Element, error) {
+	w, err := p.utilityWorld()
+	if err != nil {
+		return nil, err
+	}
+
+	el, err := w.page.Context(p.ctx).ElementByJS(evalHelper(js.ElementVisible, selector))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = el.Release() }()
+
+	node, err := el.Describe(0, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.ElementFromNode(node)
+}
+
 // ElementX retries until an element in the page that matches one of the XPath selectors, then returns
-// the matched element.
+// the matched element. XPath can express selections that CSS can't, such as selecting a row by a cell's text
+// then navigating to its sibling:
+//
+//	page.MustElementX(`//tr[td[text()="Alice"]]/following-sibling::tr[1]`)
 func (p *Page) ElementX(xPath string) (*Element, error) {
 	return p.ElementByJS(evalHelper(js.ElementX, xPath))
 }
@@ -159,6 +230,11 @@ func (p *Page) ElementX(xPath string) (*Element, error) {
 // If sleeper is nil, no retry will be performed.
 // By default, it will retry until the js function doesn't return null.
 // To customize the retry logic, check the examples of Page.Sleeper.
+// It's the escape hatch for the cases the other selectors can't cover, such as:
+//
+//	page.MustElementByJS(`() => document.querySelector('my-app').shadowRoot.querySelector('button')`)
+//
+// If the js returns a value that isn't a DOM node, an [*ExpectElementError] will be returned.
 func (p *Page) ElementByJS(opts *EvalOptions) (*Element, error) {
 	var res *proto.RuntimeRemoteObject
 	var err error
@@ -197,6 +273,45 @@ func (p *Page) Elements(selector string) (Elements, error) {
 	return p.ElementsByJS(evalHelper(js.Elements, selector))
 }
 
+// ElementCount returns the number of elements that match the css selector in one round trip.
+// It's much cheaper than len of [Page.Elements] for large lists, because no remote object is created.
+func (p *Page) ElementCount(selector string) (int, error) {
+	res, err := p.Eval(`s => document.querySelectorAll(s).length`, selector)
+	if err != nil {
+		return 0, err
+	}
+	return res.Value.Int(), nil
+}
+
+// WaitElements retries until at least min elements in the page match the css selector,
+// then returns all the matched elements. It's useful for lists that are populated asynchronously.
+// It's similar to [Page.WaitElementsMoreThan], but it returns the elements and respects the [Page.Sleeper].
+func (p *Page) WaitElements(selector string, min int) (Elements, error) {
+	return p.waitElements(selector, func(n int) bool { return n >= min })
+}
+
+// WaitElementsCount retries until exactly count elements in the page match the css selector,
+// then returns them.
+func (p *Page) WaitElementsCount(selector string, count int) (Elements, error) {
+	return p.waitElements(selector, func(n int) bool { return n == count })
+}
+
+func (p *Page) waitElements(selector string, match func(int) bool) (Elements, error) {
+	// only count the elements in each retry to avoid creating remote objects for them
+	err := utils.Retry(p.ctx, p.sleeper(), func() (bool, error) {
+		n, err := p.ElementCount(selector)
+		if err != nil {
+			return true, err
+		}
+		return match(n), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return p.Elements(selector)
+}
+
 // ElementsX returns all elements that match the XPath selector.
 func (p *Page) ElementsX(xpath string) (Elements, error) {
 	return p.ElementsByJS(evalHelper(js.ElementsX, xpath))
@@ -248,6 +363,8 @@ func (p *Page) ElementsByJS(opts *EvalOptions) (Elements, error) {
 // Search for the given query in the DOM tree until the result count is not zero, before that it will keep retrying.
 // The query can be plain text or css selector or xpath.
 // It will search nested iframes and shadow doms too.
+// Call [SearchResult.Release] to discard the search session when you are done with the result,
+// on error the session is discarded automatically.
 func (p *Page) Search(query string) (*SearchResult, error) {
 	sr := &SearchResult{
 		page:    p,
@@ -310,6 +427,11 @@ func (p *Page) Search(query string) (*SearchResult, error) {
 		return true, nil
 	})
 	if err != nil {
+		if sr.DOMPerformSearchResult != nil {
+			sr.Release()
+		} else {
+			sr.restore()
+		}
 		return nil, err
 	}
 
@@ -373,7 +495,13 @@ type RaceContext struct {
 	branches []*raceBranch
 }
 
-// Race creates a context to race selectors.
+// Race creates a context to race selectors. All the selectors are polled in each retry with the page's sleeper,
+// the first one that matches wins and runs its handler, such as for a login flow that may succeed or fail:
+//
+//	page.Race().
+//		Element(".dashboard").MustHandle(func(e *rod.Element) { fmt.Println("logged in") }).
+//		Element(".error").MustHandle(func(e *rod.Element) { panic(e.MustText()) }).
+//		MustDo()
 func (p *Page) Race() *RaceContext {
 	return &RaceContext{page: p}
 }
@@ -400,6 +528,13 @@ func (rc *RaceContext) ElementX(selector string) *RaceContext {
 	})
 }
 
+// ElementVisible is similar to [Page.ElementVisible].
+func (rc *RaceContext) ElementVisible(selector string) *RaceContext {
+	return rc.ElementFunc(func(p *Page) (*Element, error) {
+		return p.ElementVisible(selector)
+	})
+}
+
 // ElementR is similar to [Page.ElementR].
 func (rc *RaceContext) ElementR(selector, regex string) *RaceContext {
 	return rc.ElementFunc(func(p *Page) (*Element, error) {
@@ -457,6 +592,7 @@ func (rc *RaceContext) Do() (*Element, error) {
 }
 
 // Has an element that matches the css selector.
+// It's similar to [Page.Has], but only the descendants of the element will be checked.
 func (el *Element) Has(selector string) (bool, *Element, error) {
 	el, err := el.Element(selector)
 	if errors.Is(err, &ElementNotFoundError{}) {
@@ -489,11 +625,14 @@ func (el *Element) Element(selector string) (*Element, error) {
 }
 
 // ElementR returns the first child element that matches the css selector and its text matches the jsRegex.
+// It's similar to [Page.ElementR], but only the descendants of the element will be searched.
 func (el *Element) ElementR(selector, jsRegex string) (*Element, error) {
 	return el.ElementByJS(evalHelper(js.ElementR, selector, jsRegex))
 }
 
 // ElementX returns the first child that matches the XPath selector.
+// The XPath should start with "." to be relative to the element, such as ".//td",
+// or "//td" will search from the document root.
 func (el *Element) ElementX(xPath string) (*Element, error) {
 	return el.ElementByJS(evalHelper(js.ElementX, xPath))
 }
@@ -512,7 +651,14 @@ func (el *Element) Parent() (*Element, error) {
 	return el.ElementByJS(Eval(`() => this.parentElement`))
 }
 
-// Parents that match the selector.
+// Closest returns the nearest ancestor that matches the css selector.
+// The element itself is excluded. If no ancestor matches, it will return [ElementNotFoundError].
+func (el *Element) Closest(selector string) (*Element, error) {
+	return el.ElementByJS(Eval(`s => this.parentElement && this.parentElement.closest(s)`, selector))
+}
+
+// Parents that match the selector, ordered from the nearest to the farthest.
+// Use "*" as the selector to get the whole ancestor chain.
 func (el *Element) Parents(selector string) (Elements, error) {
 	return el.ElementsByJS(evalHelper(js.Parents, selector))
 }
@@ -527,6 +673,13 @@ func (el *Element) Previous() (*Element, error) {
 	return el.ElementByJS(Eval(`() => this.previousElementSibling`))
 }
 
+// Siblings returns all the sibling elements in the DOM tree, the current element is excluded.
+// Text nodes are skipped.
+func (el *Element) Siblings() (Elements, error) {
+	return el.ElementsByJS(Eval(`() => this.parentElement ?
+		Array.from(this.parentElement.children).filter(e => e !== this) : []`))
+}
+
 // Elements returns all elements that match the css selector.
 func (el *Element) Elements(selector string) (Elements, error) {
 	return el.ElementsByJS(evalHelper(js.Elements, selector))
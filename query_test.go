@@ -3,6 +3,7 @@ package rod_test
 import (
 	"context"
 	"errors"
+	"io"
 	"testing"
 	"time"
 
@@ -24,6 +25,40 @@ func TestPageElements(t *testing.T) {
 	g.Eq("submit", list.Last().MustText())
 }
 
+func TestPageElementCount(t *testing.T) {
+	g := setup(t)
+
+	page := g.page.MustNavigate(g.srcFile("fixtures/input.html"))
+	g.Eq(page.MustElementCount("input"), len(page.MustElements("input")))
+	g.Eq(page.MustElementCount("not-exists"), 0)
+
+	_, err := page.ElementCount("[")
+	g.Is(err, &rod.EvalError{})
+}
+
+func TestPageWaitElements(t *testing.T) {
+	g := setup(t)
+
+	page := g.page.MustNavigate(g.blank())
+	page.MustEval(`() => {
+		let n = 0
+		const tmr = setInterval(() => {
+			document.body.appendChild(document.createElement('p'))
+			if (++n === 3) clearInterval(tmr)
+		}, 30)
+	}`)
+
+	g.Gte(len(page.MustWaitElements("p", 2)), 2)
+	g.Len(page.MustWaitElementsCount("p", 3), 3)
+
+	s := func() utils.Sleeper { return utils.CountSleeper(3) }
+	_, err := page.Sleeper(s).WaitElementsCount("p", 4)
+	g.Is(err, &utils.MaxSleepCountError{})
+
+	g.mc.stubErr(1, proto.RuntimeCallFunctionOn{})
+	g.Err(page.WaitElements("p", 1))
+}
+
 func TestPagesQuery(t *testing.T) {
 	g := setup(t)
 
@@ -99,6 +134,43 @@ func TestElementHas(t *testing.T) {
 	g.False(b.MustHasR("button", "11"))
 }
 
+func TestHasElement(t *testing.T) {
+	g := setup(t)
+
+	p := g.page.MustNavigate(g.html(`<div><p>a</p></div><p>b</p>`))
+
+	has, el, err := p.Has("p")
+	g.E(err)
+	g.True(has)
+	g.Eq(el.MustText(), "a")
+
+	has, el, err = p.Has("a")
+	g.E(err)
+	g.False(has)
+	g.Nil(el)
+
+	div := p.MustElement("div")
+	has, el, err = div.Has("p")
+	g.E(err)
+	g.True(has)
+	g.Eq(el.MustText(), "a")
+
+	has, el, err = el.Has("p")
+	g.E(err)
+	g.False(has)
+	g.Nil(el)
+
+	g.mc.stubErr(1, proto.RuntimeCallFunctionOn{})
+	has, _, err = p.Has("p")
+	g.Err(err)
+	g.False(has)
+
+	g.mc.stubErr(1, proto.RuntimeCallFunctionOn{})
+	has, _, err = div.Has("p")
+	g.Err(err)
+	g.False(has)
+}
+
 func TestSearch(t *testing.T) {
 	g := setup(t)
 
@@ -167,8 +239,17 @@ func TestSearchElements(t *testing.T) {
 
 	{ // disable retry
 		sleeper := func() utils.Sleeper { return utils.CountSleeper(1) }
+		discarded := false
+		g.mc.setCall(func(ctx context.Context, sessionID, method string, params interface{}) ([]byte, error) {
+			if method == (proto.DOMDiscardSearchResults{}).ProtoReq() {
+				discarded = true
+			}
+			return g.mc.principal.Call(ctx, sessionID, method, params)
+		})
 		_, err := p.Sleeper(sleeper).Search("not-exists")
+		g.mc.resetCall()
 		g.Err(err)
+		g.True(discarded)
 	}
 }
 
@@ -181,6 +262,17 @@ func TestSearchIframes(t *testing.T) {
 	g.True(el.MustClick().MustMatches("[a=ok]"))
 }
 
+func TestSearchNestedIframeContext(t *testing.T) {
+	g := setup(t)
+
+	p := g.page.MustNavigate(g.srcFile("fixtures/click-iframes.html"))
+
+	// the element in the deepest frame should get the js context of its own frame
+	el := p.MustSearch("button[onclick]")
+	g.Regex(`/fixtures/click.html\z`, el.MustEval(`() => location.href`).Str())
+	g.Eq(el.MustElementX(`./ancestor::body`).MustElement("button").MustText(), "click me")
+}
+
 func TestSearchIframesAfterReload(t *testing.T) {
 	g := setup(t)
 
@@ -234,6 +326,18 @@ func TestPageRace(t *testing.T) {
 	g.Nil(el)
 }
 
+func TestPageRaceElementVisible(t *testing.T) {
+	g := setup(t)
+
+	p := g.page.MustNavigate(g.html(`<p class="error" style="display: none">err</p>
+		<script>setTimeout(() => document.body.insertAdjacentHTML('beforeend', '<p class="ok">ok</p>'), 300)</script>`))
+
+	el := p.Race().ElementVisible(".error").MustHandle(func(_ *rod.Element) {
+		panic("the hidden element shouldn't win")
+	}).ElementVisible(".ok").MustDo()
+	g.Eq(el.MustText(), "ok")
+}
+
 func TestPageRaceRetryInHandle(t *testing.T) {
 	g := setup(t)
 
@@ -276,6 +380,37 @@ func TestPageElementsX(t *testing.T) {
 	g.Len(list, 4)
 }
 
+func TestPageElementVisible(t *testing.T) {
+	g := setup(t)
+
+	p := g.page.MustNavigate(g.html(`
+		<button style="display: none">a</button>
+		<button style="visibility: hidden">b</button>
+		<button>c</button>
+	`))
+
+	g.Eq(p.MustElement("button").MustText(), "a")
+	g.Eq(p.MustElementVisible("button").MustText(), "c")
+
+	_, err := p.Sleeper(rod.NotFoundSleeper).ElementVisible("div")
+	g.Is(err, &rod.ElementNotFoundError{})
+}
+
+func TestElementXAxis(t *testing.T) {
+	g := setup(t)
+
+	p := g.page.MustNavigate(g.html(`<table>
+		<tr><td>Alice</td><td>1</td></tr>
+		<tr><td>Bob</td><td>2</td></tr>
+		<tr><td>Carol</td><td>3</td></tr>
+	</table>`))
+
+	row := p.MustElementX(`//tr[td[text()="Alice"]]/following-sibling::tr[1]`)
+	g.Eq(row.MustElementX(`./td[2]`).MustText(), "2")
+	g.Len(row.MustElementsX(`.//td`), 2)
+	g.Len(row.MustElementsX(`//td`), 6)
+}
+
 func TestElementR(t *testing.T) {
 	g := setup(t)
 
@@ -335,6 +470,18 @@ func TestElementParents(t *testing.T) {
 	g.Len(p.MustElement("option").MustParents("form"), 1)
 }
 
+func TestElementClosest(t *testing.T) {
+	g := setup(t)
+
+	p := g.page.MustNavigate(g.srcFile("fixtures/input.html"))
+	el := p.MustElement("option")
+	g.Eq("FORM", el.MustClosest("form").MustEval(`() => this.tagName`).String())
+	g.Eq("SELECT", el.MustClosest("*").MustEval(`() => this.tagName`).String())
+
+	_, err := el.Closest("option")
+	g.Is(err, &rod.ElementNotFoundError{})
+}
+
 func TestElementSiblings(t *testing.T) {
 	g := setup(t)
 
@@ -345,6 +492,31 @@ func TestElementSiblings(t *testing.T) {
 
 	g.Eq(a.MustText(), "01")
 	g.Eq(b.MustText(), "04")
+
+	list := el.MustSiblings()
+	g.Len(list, 3)
+	g.Eq("SPAN", list.First().MustEval(`() => this.tagName`).String())
+	g.Eq("04", list.Last().MustText())
+
+	last := el.MustElement("button:last-child")
+	_, err := last.Next()
+	g.Is(err, &rod.ElementNotFoundError{})
+
+	g.Len(p.MustElement("html").MustSiblings(), 0)
+
+	g.mc.stubErr(1, proto.RuntimeCallFunctionOn{})
+	g.Err(el.Siblings())
+}
+
+func TestElementFromElementR(t *testing.T) {
+	g := setup(t)
+
+	p := g.page.MustNavigate(g.srcFile("fixtures/selector.html"))
+	el := p.MustElement("div")
+	g.Eq("03", el.MustElementR("button", "0[34]").MustText())
+
+	_, err := el.ElementR("button", "01")
+	g.Is(err, &rod.ElementNotFoundError{})
 }
 
 func TestElementFromElementX(t *testing.T) {
@@ -387,6 +559,19 @@ func TestPageElementByJS(t *testing.T) {
 	_, err := p.ElementByJS(rod.Eval(`() => 1`))
 	g.Is(err, &rod.ExpectElementError{})
 	g.Eq(err.Error(), "expect js to return an element, but got: {\"type\":\"number\",\"value\":1,\"description\":\"1\"}")
+
+	p.MustEval(`() => {
+		const host = document.createElement('div')
+		host.id = 'host'
+		host.attachShadow({ mode: 'open' }).innerHTML = '<i>a</i><i style="display:none">b</i><i>c</i>'
+		document.body.appendChild(host)
+	}`)
+	el := p.MustElementByJS(`(n) => [...document.querySelector('#host').shadowRoot.querySelectorAll('i')]
+		.filter(e => e.offsetParent !== null)[n]`, 1)
+	g.Eq(el.MustText(), "c")
+
+	_, err = p.ElementByJS(rod.Eval(`() => ({})`))
+	g.Is(err, &rod.ExpectElementError{})
 }
 
 func TestPageElementsByJS(t *testing.T) {
@@ -438,3 +623,36 @@ func TestElementsOthers(t *testing.T) {
 	g.Nil(list.First())
 	g.Nil(list.Last())
 }
+
+func TestElementsEachFilter(t *testing.T) {
+	g := setup(t)
+
+	p := g.page.MustNavigate(g.html(`<input type="checkbox" checked><input type="checkbox"><input type="checkbox" checked>`))
+	list := p.MustElements("input")
+
+	checked, err := list.Filter(`() => this.checked`)
+	g.E(err)
+	g.Len(checked, 2)
+
+	indexed, err := list.Filter(`(i) => this === document.querySelectorAll('input')[i]`, 1)
+	g.E(err)
+	g.Len(indexed, 1)
+	g.True(indexed.First().MustEqual(list[1]))
+
+	visited := []int{}
+	g.E(list.Each(func(i int, _ *rod.Element) error {
+		visited = append(visited, i)
+		return nil
+	}))
+	g.Eq(visited, []int{0, 1, 2})
+
+	g.Eq(list.Each(func(i int, _ *rod.Element) error {
+		if i == 1 {
+			return io.EOF
+		}
+		return nil
+	}), io.EOF)
+
+	g.E(list.Release())
+	g.Err(list.Filter(`() => true`))
+}
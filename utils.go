@@ -73,6 +73,9 @@ var DefaultLogger = log.New(os.Stdout, "[rod] ", log.LstdFlags)
 //
 // Why the default is not RequestAnimationFrame or DOM change events is because of if a retry never
 // ends it can easily flood the program. But you can always easily config it into what you want.
+// Use [Browser.Sleeper] to set the sleeper for all the operations of a browser, or [Page.Sleeper] and
+// [Element.Sleeper] for the chained sub-operations, [utils.BackoffSleeper] and [utils.ScaleBackoff]
+// can tune the initial interval, max interval, and growth factor.
 var DefaultSleeper = func() utils.Sleeper {
 	return utils.BackoffSleeper(100*time.Millisecond, time.Second, nil)
 }